@@ -0,0 +1,288 @@
+package internal
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+const chanConsName = "Chan"
+
+func chanType(item Type) *TypeCons {
+	return &TypeCons{
+		Name: chanConsName,
+		Args: []Type{item},
+	}
+}
+
+// recvType is what receiving from a channel gives: a message, or Closed once
+// the channel is closed and drained.
+func recvType(msg Type) *TypeRec {
+	return &TypeRec{
+		Entries: map[string]Type{"Msg": msg, "Closed": unitType},
+		RestVar: nil,
+		Union:   true,
+	}
+}
+
+var closedVal = &ConsVal{Name: "Closed", Payload: unitVal}
+
+// ChanVal is a channel between tasks. Its Go channel is never closed, so
+// that sending can fail instead of panicking; done is closed instead.
+type ChanVal struct {
+	ch        chan Val
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newChanVal(capacity int) *ChanVal {
+	return &ChanVal{
+		ch:   make(chan Val, capacity),
+		done: make(chan struct{}),
+	}
+}
+
+func (c *ChanVal) val() {}
+
+func (c *ChanVal) Pretty(indent int) string {
+	return dent(indent, "<chan>")
+}
+
+func (c *ChanVal) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+	})
+}
+
+// drain returns a message left in a closed channel, or Closed.
+func (c *ChanVal) drain() Val {
+	select {
+	case msg := <-c.ch:
+		return &ConsVal{Name: "Msg", Payload: msg}
+	default:
+		return closedVal
+	}
+}
+
+func (c *ChanVal) send(e *Evaluator, msg Val) (Val, error) {
+	select {
+	case <-c.done:
+		return nil, &TaskError{Val: closedVal}
+	default:
+	}
+
+	select {
+	case c.ch <- msg:
+		return unitVal, nil
+	case <-c.done:
+		return nil, &TaskError{Val: closedVal}
+	case <-e.ctx.Done():
+		return nil, e.ctx.Err()
+	}
+}
+
+func (c *ChanVal) recv(e *Evaluator) (Val, error) {
+	select {
+	case msg := <-c.ch:
+		return &ConsVal{Name: "Msg", Payload: msg}, nil
+	case <-c.done:
+		return c.drain(), nil
+	case <-e.ctx.Done():
+		return nil, e.ctx.Err()
+	}
+}
+
+// selectRecv receives from whichever of chans has a message first, giving
+// its index along with the message. Closed channels are skipped; once all
+// of them are closed and drained, the result is Closed.
+func selectRecv(e *Evaluator, chans []*ChanVal) (Val, error) {
+	open := make([]bool, len(chans))
+	for i := range open {
+		open[i] = true
+	}
+
+	for lo.Contains(open, true) {
+		// a case for the messages and one for the closing of every open
+		// channel, and a last one for cancellation
+		var cases []reflect.SelectCase
+		for i, c := range chans {
+			ch, done := reflect.Value{}, reflect.Value{}
+			if open[i] {
+				ch, done = reflect.ValueOf(c.ch), reflect.ValueOf(c.done)
+			}
+			cases = append(cases,
+				reflect.SelectCase{Dir: reflect.SelectRecv, Chan: ch},
+				reflect.SelectCase{Dir: reflect.SelectRecv, Chan: done},
+			)
+		}
+		cases = append(cases, reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(e.ctx.Done())})
+
+		chosen, recv, _ := reflect.Select(cases)
+		if chosen == len(cases)-1 {
+			return nil, e.ctx.Err()
+		}
+
+		i := chosen / 2
+		var msg Val
+		if chosen%2 == 0 {
+			msg = recv.Interface().(Val)
+		} else {
+			drained := chans[i].drain().(*ConsVal)
+			if drained.Name == "Closed" {
+				open[i] = false
+				continue
+			}
+			msg = drained.Payload
+		}
+
+		return &ConsVal{Name: "Msg", Payload: &TupleVal{Items: []Val{&Int{Value: i}, msg}}}, nil
+	}
+
+	return closedVal, nil
+}
+
+func chanArg(arg Val) (*ChanVal, error) {
+	c, ok := arg.(*ChanVal)
+	if !ok {
+		return nil, errors.Errorf("invalid channel type %t", arg)
+	}
+	return c, nil
+}
+
+func chanItems() map[string]Item {
+	return map[string]Item{
+		"new_chan": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type:   lamType(intType, taskType(chanType(&TypeVar{Name: "a"}), unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "new_chan",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					capacity, ok := args[0].(*Int)
+					if !ok || capacity.Value < 0 {
+						return nil, errors.Errorf("invalid channel capacity %s", args[0].Pretty(0))
+					}
+
+					return thunk("new_chan", func(e *Evaluator) (Val, error) {
+						return newChanVal(capacity.Value), nil
+					}), nil
+				},
+			},
+		},
+		"send": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					chanType(&TypeVar{Name: "a"}),
+					&TypeVar{Name: "a"},
+					taskType(unitType, unionType(map[string]Type{"Closed": unitType}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "send",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					c, err := chanArg(args[0])
+					if err != nil {
+						return nil, err
+					}
+					msg := args[1]
+
+					return thunk("send", func(e *Evaluator) (Val, error) {
+						return c.send(e, msg)
+					}), nil
+				},
+			},
+		},
+		"recv": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					chanType(&TypeVar{Name: "a"}),
+					taskType(recvType(&TypeVar{Name: "a"}), unionType(map[string]Type{}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "recv",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					c, err := chanArg(args[0])
+					if err != nil {
+						return nil, err
+					}
+
+					return thunk("recv", c.recv), nil
+				},
+			},
+		},
+		"close": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					chanType(&TypeVar{Name: "a"}),
+					taskType(unitType, unionType(map[string]Type{}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "close",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					c, err := chanArg(args[0])
+					if err != nil {
+						return nil, err
+					}
+
+					return thunk("close", func(e *Evaluator) (Val, error) {
+						c.close()
+						return unitVal, nil
+					}), nil
+				},
+			},
+		},
+		"select": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					listType(chanType(&TypeVar{Name: "a"})),
+					taskType(recvType(tupleType(intType, &TypeVar{Name: "a"})), unionType(map[string]Type{}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "select",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					list, ok := args[0].(*ListVal)
+					if !ok {
+						return nil, errors.Errorf("invalid channels type %t", args[0])
+					}
+					var chans []*ChanVal
+					for _, item := range list.Items {
+						c, err := chanArg(item)
+						if err != nil {
+							return nil, err
+						}
+						chans = append(chans, c)
+					}
+
+					return thunk("select", func(e *Evaluator) (Val, error) {
+						return selectRecv(e, chans)
+					}), nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package internal
+
+import (
+	"context"
+	"time"
+)
+
+// Clock is the time source of a program. Replacing it, for example with one
+// that only advances when slept on, makes tasks that wait deterministic.
+type Clock interface {
+	Now() time.Time
+	// Sleep waits for d, or until ctx is cancelled.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
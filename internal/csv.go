@@ -0,0 +1,340 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// csvReadOptionType is the type of the options of the functions reading
+// CSV.
+var csvReadOptionType = &TypeRec{
+	Entries: map[string]Type{
+		"Delimiter":  strType,
+		"Comment":    strType,
+		"LazyQuotes": unitType,
+		"TrimSpace":  unitType,
+	},
+	RestVar: nil,
+	Union:   true,
+}
+
+// csvWriteOptionType is the type of the options of to_csv.
+var csvWriteOptionType = &TypeRec{
+	Entries: map[string]Type{
+		"Delimiter": strType,
+		"Crlf":      unitType,
+	},
+	RestVar: nil,
+	Union:   true,
+}
+
+var csvErrorType = &TypeRec{
+	Entries: map[string]Type{
+		"line":    intType,
+		"column":  intType,
+		"message": strType,
+	},
+	RestVar: nil,
+	Union:   false,
+}
+
+func csvResultType(row Type) *TypeRec {
+	return &TypeRec{
+		Entries: map[string]Type{"Ok": listType(row), "Err": csvErrorType},
+		RestVar: nil,
+		Union:   true,
+	}
+}
+
+func csvRowType(header bool) Type {
+	if header {
+		return dictType(strType, strType)
+	}
+	return listType(strType)
+}
+
+func delimiter(option *ConsVal) (rune, error) {
+	payload, err := strArg(option.Payload)
+	if err != nil {
+		return 0, err
+	}
+	str := payload.Value
+	if utf8.RuneCountInString(str) != 1 {
+		return 0, errors.Errorf("invalid %s `%s`, expected a single character", strings.ToLower(option.Name), str)
+	}
+	r, _ := utf8.DecodeRuneInString(str)
+	return r, nil
+}
+
+// csvOption returns an option given to a CSV function.
+func csvOption(option Val) (*ConsVal, error) {
+	cons, ok := option.(*ConsVal)
+	if !ok {
+		return nil, errors.Errorf("invalid option type %t", option)
+	}
+	return cons, nil
+}
+
+func csvReader(r io.Reader, options []Val) (*csv.Reader, error) {
+	reader := csv.NewReader(r)
+	for _, option := range options {
+		cons, err := csvOption(option)
+		if err != nil {
+			return nil, err
+		}
+		switch cons.Name {
+		case "Delimiter", "Comment":
+			r, err := delimiter(cons)
+			if err != nil {
+				return nil, err
+			}
+			if cons.Name == "Delimiter" {
+				reader.Comma = r
+			} else {
+				reader.Comment = r
+			}
+		case "LazyQuotes":
+			reader.LazyQuotes = true
+		case "TrimSpace":
+			reader.TrimLeadingSpace = true
+		default:
+			return nil, errors.Errorf("unknown option %s", cons.Name)
+		}
+	}
+	return reader, nil
+}
+
+// csvError is the value a failure to read CSV is reported with.
+func csvError(err error) Val {
+	line, column := 0, 0
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		line, column, err = parseErr.Line, parseErr.Column, parseErr.Err
+	}
+
+	return &RecVal{Entries: map[string]Val{
+		"line":    &Int{Value: line},
+		"column":  &Int{Value: column},
+		"message": &LitStr{Value: err.Error()},
+	}}
+}
+
+// readCSV calls row with each record of reader in turn. With header, the
+// first record names the fields and the others are passed as dictionaries
+// from field names to values.
+func readCSV(reader *csv.Reader, header bool, row func(Val) error) error {
+	var names []string
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if !header {
+			if err := row(strList(record)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if names == nil {
+			names = record
+			continue
+		}
+		dict := newDict()
+		for i, name := range names {
+			dict.set(&LitStr{Value: name}, &LitStr{Value: record[i]})
+		}
+		if err := row(dict); err != nil {
+			return err
+		}
+	}
+}
+
+func parseCSVBuiltin(name string, header bool) Item {
+	return Item{
+		Type: &Scheme{
+			Forall: nil,
+			Type:   lamType(strType, listType(csvReadOptionType), csvResultType(csvRowType(header))),
+		},
+		Val: pureBuiltin(name, 2, func(args []Val) (Val, error) {
+			text, err := strArg(args[0])
+			if err != nil {
+				return nil, err
+			}
+			options, err := listArg(args[1])
+			if err != nil {
+				return nil, err
+			}
+
+			reader, err := csvReader(strings.NewReader(text.Value), options.Items)
+			if err != nil {
+				return &ConsVal{Name: "Err", Payload: csvError(err)}, nil
+			}
+
+			var rows []Val
+			err = readCSV(reader, header, func(row Val) error {
+				rows = append(rows, row)
+				return nil
+			})
+			if err != nil {
+				return &ConsVal{Name: "Err", Payload: csvError(err)}, nil
+			}
+			return okResult(&ListVal{Items: rows}), nil
+		}),
+	}
+}
+
+// readCSVBuiltin reads a CSV file record by record, running the task the
+// handler returns for each before reading the next. The task returns the
+// number of records handled.
+func readCSVBuiltin(name string, header bool) Item {
+	errType := fsErrorType("e")
+	errType.Entries["CsvError"] = csvErrorType
+
+	return Item{
+		Type: &Scheme{
+			Forall: []string{"a", "e"},
+			Type: lamType(
+				strType,
+				listType(csvReadOptionType),
+				lamType(csvRowType(header), taskType(&TypeVar{Name: "a"}, errType)),
+				taskType(intType, errType),
+			),
+		},
+		Val: &Builtin{
+			Name: name,
+			Impl: func(e *Evaluator, args []Val) (Val, error) {
+				if len(args) != 3 {
+					return nil, errors.Errorf("expecting 3 arguments, got %d", len(args))
+				}
+				path, err := strArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				options, err := listArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+				handler := args[2]
+
+				return thunk(name, func(e *Evaluator) (Val, error) {
+					file, err := os.Open(e.path(path.Value))
+					if err != nil {
+						return nil, fsError(err, path.Value)
+					}
+					defer file.Close()
+
+					reader, err := csvReader(file, options.Items)
+					if err != nil {
+						return nil, &TaskError{Val: &ConsVal{Name: "CsvError", Payload: csvError(err)}}
+					}
+
+					count := 0
+					var handlerErr error
+					err = readCSV(reader, header, func(row Val) error {
+						task, err := e.evalFn(handler, []Val{row})
+						if err == nil {
+							_, err = e.evalFn(task, nil)
+						}
+						if err != nil {
+							handlerErr = err
+							return err
+						}
+						count++
+						return nil
+					})
+					if handlerErr != nil {
+						return nil, handlerErr
+					}
+					if err != nil {
+						var parseErr *csv.ParseError
+						if !errors.As(err, &parseErr) {
+							return nil, fsError(err, path.Value)
+						}
+						return nil, &TaskError{Val: &ConsVal{Name: "CsvError", Payload: csvError(err)}}
+					}
+					return &Int{Value: count}, nil
+				}), nil
+			},
+		},
+	}
+}
+
+func csvItems() map[string]Item {
+	return map[string]Item{
+		"parse_csv":        parseCSVBuiltin("parse_csv", false),
+		"parse_csv_header": parseCSVBuiltin("parse_csv_header", true),
+		"read_csv":         readCSVBuiltin("read_csv", false),
+		"read_csv_header":  readCSVBuiltin("read_csv_header", true),
+		"to_csv": {
+			Type: &Scheme{
+				Forall: nil,
+				Type:   lamType(listType(listType(strType)), listType(csvWriteOptionType), strType),
+			},
+			Val: pureBuiltin("to_csv", 2, func(args []Val) (Val, error) {
+				rows, err := listArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				options, err := listArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+
+				var buf bytes.Buffer
+				writer := csv.NewWriter(&buf)
+				for _, option := range options.Items {
+					cons, err := csvOption(option)
+					if err != nil {
+						return nil, err
+					}
+					switch cons.Name {
+					case "Delimiter":
+						r, err := delimiter(cons)
+						if err != nil {
+							return nil, err
+						}
+						writer.Comma = r
+					case "Crlf":
+						writer.UseCRLF = true
+					default:
+						return nil, errors.Errorf("unknown option %s", cons.Name)
+					}
+				}
+
+				for _, row := range rows.Items {
+					fields, err := listArg(row)
+					if err != nil {
+						return nil, err
+					}
+					var record []string
+					for _, field := range fields.Items {
+						str, err := strArg(field)
+						if err != nil {
+							return nil, err
+						}
+						record = append(record, str.Value)
+					}
+					if err := writer.Write(record); err != nil {
+						return nil, err
+					}
+				}
+				writer.Flush()
+				if err := writer.Error(); err != nil {
+					return nil, err
+				}
+				return &LitStr{Value: buf.String()}, nil
+			}),
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package internal
+
+import "testing"
+
+func TestCSVBuiltinsRejectWrongArguments(t *testing.T) {
+	items := csvItems()
+	for name, args := range map[string][]Val{
+		"parse_csv": {&Int{Value: 1}, &ListVal{}},
+		"to_csv":    {&ListVal{Items: []Val{&ListVal{Items: []Val{&Int{Value: 1}}}}}, &ListVal{}},
+	} {
+		builtin := items[name].Val.(*Builtin)
+		if _, err := builtin.Impl(nil, args); err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+
+	builtin := dictItems()["dict_from_list"].Val.(*Builtin)
+	if _, err := builtin.Impl(nil, []Val{&ListVal{Items: []Val{&Int{Value: 1}}}}); err == nil {
+		t.Error("dict_from_list: got no error")
+	}
+}
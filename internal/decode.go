@@ -0,0 +1,348 @@
+package internal
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+const decodeName = "decode"
+
+// decodeScheme is the type of decode. What it decodes to is only known from
+// the type it is used at, which the inferrer records on the Var.
+var decodeScheme = &Scheme{
+	Forall:  []string{"a"},
+	Type:    lamType(jsonType, resultType(&TypeVar{Name: "a"})),
+	typeArg: true,
+}
+
+// recordTypeArg notes a use of decode, so that the type it is used at can be
+// resolved once the module's types are known. Only uses of the builtin's
+// binding are recorded: a decode bound by the program itself is left alone.
+func (i *Inferrer) recordTypeArg(v *Var, scheme *Scheme, t Type) {
+	if !scheme.typeArg {
+		return
+	}
+
+	v.TypeArg = t
+	i.typeArgs = append(i.typeArgs, v)
+}
+
+// resolveTypeArgs sets the types decode is used at since the start-th use,
+// given the substitution inferred for the module.
+func (i *Inferrer) resolveTypeArgs(start int, subst *Subst) error {
+	uses := i.typeArgs[start:]
+	i.typeArgs = i.typeArgs[:start]
+
+	for _, v := range uses {
+		t := v.TypeArg
+		// the substitution is not idempotent, so apply it until nothing changes
+		for range len(subst.Subst) + 1 {
+			if !t.freeVars().HasAny(lo.Keys(subst.Subst)...) {
+				break
+			}
+			t = t.apply(subst)
+		}
+
+		target, err := decodeTarget(t)
+		if err != nil {
+			return err
+		}
+		if err := checkDecodable(target); err != nil {
+			return errors.WithMessagef(err, "cannot decode to %s", target.Pretty(0))
+		}
+		v.TypeArg = target
+	}
+
+	return nil
+}
+
+// decodeTarget returns the type decode decodes to, given the type it is used
+// at.
+func decodeTarget(t Type) (Type, error) {
+	lam, ok := t.(*TypeCons)
+	if !ok || lam.Name != lambdaConsName || len(lam.Args) != 2 {
+		return nil, errors.Errorf("invalid decode type %s", t.Pretty(0))
+	}
+	result, ok := lam.Args[1].(*TypeRec)
+	if !ok {
+		return nil, errors.Errorf("invalid decode type %s", t.Pretty(0))
+	}
+	target, ok := result.Entries["Ok"]
+	if !ok {
+		return nil, errors.Errorf("invalid decode type %s", t.Pretty(0))
+	}
+	return target, nil
+}
+
+func checkDecodable(t Type) error {
+	switch t := t.(type) {
+	case *TypeVar:
+		return errors.New("the type to decode to must be known, annotate it")
+	case *TypeCons:
+		switch t.Name {
+		case intConsName, strConsName, floatConsName, bytesConsName, instantConsName, durationConsName, jsonConsName:
+			return nil
+		case listConsName, tupleConsName:
+			for _, arg := range t.Args {
+				if err := checkDecodable(arg); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		return errors.Errorf("%s values cannot be decoded", t.Name)
+	case *TypeRec:
+		if t.RestVar != nil {
+			return errors.New("the type to decode to must be known, annotate it")
+		}
+		for _, entry := range t.Entries {
+			if err := checkDecodable(entry); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return errors.Errorf("invalid type %T", t)
+}
+
+// jsonInt returns the integer doc holds. A number parsed from text is read
+// from it exactly; one built with json_of only up to 2^53, which its Float
+// holds exactly.
+func jsonInt(doc *JsonVal) (int, bool) {
+	if doc.Value.Name != "JNumber" {
+		return 0, false
+	}
+	if n, err := doc.Number.Int64(); err == nil {
+		return int(n), true
+	}
+	f, ok := doc.Value.Payload.(*FloatVal)
+	if !ok || f.Value != math.Trunc(f.Value) || math.Abs(f.Value) > 1<<53 {
+		return 0, false
+	}
+	return int(f.Value), true
+}
+
+func isBoolType(rec *TypeRec) bool {
+	_, hasTrue := rec.Entries["True"]
+	_, hasFalse := rec.Entries["False"]
+	return rec.Union && len(rec.Entries) == 2 && hasTrue && hasFalse
+}
+
+func isUnitType(t Type) bool {
+	rec, ok := t.(*TypeRec)
+	return ok && !rec.Union && len(rec.Entries) == 0
+}
+
+// objectEntries returns the entries of a JSON object by key. Of repeated
+// keys, the last one counts.
+func objectEntries(doc *ConsVal) (map[string]*JsonVal, error) {
+	entries := map[string]*JsonVal{}
+	items, err := jsonItemsOf(doc)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		entry, ok := item.(*TupleVal)
+		if !ok || len(entry.Items) != 2 {
+			return nil, errors.Errorf("invalid object entry type %t", item)
+		}
+		key, err := strArg(entry.Items[0])
+		if err != nil {
+			return nil, err
+		}
+		val, err := jsonArg(entry.Items[1])
+		if err != nil {
+			return nil, err
+		}
+		entries[key.Value] = val
+	}
+	return entries, nil
+}
+
+// jsonItemsOf returns the items of a JSON array or the entries of an object.
+func jsonItemsOf(doc *ConsVal) ([]Val, error) {
+	list, ok := doc.Payload.(*ListVal)
+	if !ok {
+		return nil, errors.Errorf("invalid %s payload type %t", doc.Name, doc.Payload)
+	}
+	return list.Items, nil
+}
+
+// decodeJSON converts doc to a value of type t, which checkDecodable
+// accepts. Errors start with the path of the part of doc that does not fit,
+// like `$.users[3].email: expected Str`.
+func decodeJSON(t Type, doc *JsonVal, path string) (Val, error) {
+	expected := func(what string) error {
+		return errors.Errorf("%s: expected %s", path, what)
+	}
+
+	payload := doc.Value.Payload
+	switch t := t.(type) {
+	case *TypeCons:
+		switch t.Name {
+		case jsonConsName:
+			return doc, nil
+		case intConsName, durationConsName:
+			n, ok := jsonInt(doc)
+			if !ok {
+				return nil, expected(t.Name)
+			}
+			if t.Name == durationConsName {
+				return &DurationVal{Value: time.Duration(n) * time.Millisecond}, nil
+			}
+			return &Int{Value: n}, nil
+		case floatConsName:
+			if doc.Value.Name != "JNumber" {
+				return nil, expected(t.Name)
+			}
+			return payload, nil
+		case strConsName:
+			if doc.Value.Name != "JString" {
+				return nil, expected(t.Name)
+			}
+			return payload, nil
+		case bytesConsName:
+			if doc.Value.Name != "JString" {
+				return nil, expected("base64 Bytes")
+			}
+			str, err := strArg(payload)
+			if err != nil {
+				return nil, err
+			}
+			b, err := base64.StdEncoding.DecodeString(str.Value)
+			if err != nil {
+				return nil, expected("base64 Bytes")
+			}
+			return &BytesVal{Value: b}, nil
+		case instantConsName:
+			if doc.Value.Name != "JString" {
+				return nil, expected("an RFC 3339 Instant")
+			}
+			str, err := strArg(payload)
+			if err != nil {
+				return nil, err
+			}
+			instant, err := time.Parse(time.RFC3339Nano, str.Value)
+			if err != nil {
+				return nil, expected("an RFC 3339 Instant")
+			}
+			return &InstantVal{Value: instant}, nil
+		case listConsName, tupleConsName:
+			if doc.Value.Name != "JArray" {
+				return nil, expected(t.Pretty(0))
+			}
+			items, err := jsonItemsOf(doc.Value)
+			if err != nil {
+				return nil, err
+			}
+			if t.Name == tupleConsName && len(items) != len(t.Args) {
+				return nil, expected(fmt.Sprintf("%d items", len(t.Args)))
+			}
+
+			var vals []Val
+			for i, item := range items {
+				itemType := t.Args[0]
+				if t.Name == tupleConsName {
+					itemType = t.Args[i]
+				}
+				itemDoc, err := jsonArg(item)
+				if err != nil {
+					return nil, err
+				}
+				val, err := decodeJSON(itemType, itemDoc, fmt.Sprintf("%s[%d]", path, i))
+				if err != nil {
+					return nil, err
+				}
+				vals = append(vals, val)
+			}
+			if t.Name == tupleConsName {
+				return &TupleVal{Items: vals}, nil
+			}
+			return &ListVal{Items: vals}, nil
+		}
+	case *TypeRec:
+		if isBoolType(t) {
+			if doc.Value.Name != "JBool" {
+				return nil, expected("Bool")
+			}
+			return payload, nil
+		}
+
+		if doc.Value.Name != "JObject" {
+			return nil, expected("an object")
+		}
+		entries, err := objectEntries(doc.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		if t.Union {
+			tag, has := entries["tag"]
+			if !has || tag.Value.Name != "JString" {
+				return nil, errors.Errorf("%s.tag: expected Str", path)
+			}
+			tagName, err := strArg(tag.Value.Payload)
+			if err != nil {
+				return nil, err
+			}
+			name := tagName.Value
+			payloadType, has := t.Entries[name]
+			if !has {
+				return nil, errors.Errorf("%s.tag: unexpected tag `%s`", path, name)
+			}
+
+			value, has := entries["value"]
+			if !has {
+				if isUnitType(payloadType) {
+					return &ConsVal{Name: name, Payload: unitVal}, nil
+				}
+				return nil, errors.Errorf("%s.value: missing field", path)
+			}
+			val, err := decodeJSON(payloadType, value, path+".value")
+			if err != nil {
+				return nil, err
+			}
+			return &ConsVal{Name: name, Payload: val}, nil
+		}
+
+		keys := lo.Keys(t.Entries)
+		sort.Strings(keys)
+		vals := map[string]Val{}
+		for _, key := range keys {
+			entry, has := entries[key]
+			if !has {
+				return nil, errors.Errorf("%s.%s: missing field", path, key)
+			}
+			val, err := decodeJSON(t.Entries[key], entry, path+"."+key)
+			if err != nil {
+				return nil, err
+			}
+			vals[key] = val
+		}
+		return &RecVal{Entries: vals}, nil
+	}
+
+	return nil, errors.Errorf("%s: cannot decode to %s", path, t.Pretty(0))
+}
+
+// decodeBuiltin is decode used at type [Ok t, Err Str].
+func decodeBuiltin(t Type) *Builtin {
+	return pureBuiltin(decodeName, 1, func(args []Val) (Val, error) {
+		doc, err := jsonArg(args[0])
+		if err != nil {
+			return nil, err
+		}
+		val, err := decodeJSON(t, doc, "$")
+		if err != nil {
+			return errResult(err), nil
+		}
+		return okResult(val), nil
+	})
+}
@@ -0,0 +1,22 @@
+package internal
+
+import "testing"
+
+func TestDecodeRejectsMalformedDocuments(t *testing.T) {
+	for _, tc := range []struct {
+		typ Type
+		doc *JsonVal
+	}{
+		{listType(intType), &JsonVal{Value: &ConsVal{Name: "JArray", Payload: &Int{Value: 1}}}},
+		{listType(intType), &JsonVal{Value: &ConsVal{Name: "JArray", Payload: &ListVal{Items: []Val{&Int{Value: 1}}}}}},
+		{bytesType, &JsonVal{Value: &ConsVal{Name: "JString", Payload: &Int{Value: 1}}}},
+		{
+			&TypeRec{Entries: map[string]Type{"name": strType}, RestVar: nil, Union: false},
+			&JsonVal{Value: &ConsVal{Name: "JObject", Payload: &ListVal{Items: []Val{&LitStr{Value: "name"}}}}},
+		},
+	} {
+		if _, err := decodeJSON(tc.typ, tc.doc, "$"); err == nil {
+			t.Errorf("%s: got no error", tc.doc.Value.Pretty(0))
+		}
+	}
+}
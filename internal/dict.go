@@ -0,0 +1,156 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const dictConsName = "Dict"
+
+func dictType(key, val Type) *TypeCons {
+	return &TypeCons{
+		Name: dictConsName,
+		Args: []Type{key, val},
+	}
+}
+
+// DictVal maps keys to values, keeping the order keys were first set in.
+// Keys are told apart by their pretty form, which is the same for equal
+// values of the types dictionaries are used with.
+type DictVal struct {
+	keys    []Val
+	entries map[string]Val
+}
+
+func (d *DictVal) val() {}
+
+func (d *DictVal) Pretty(indent int) string {
+	var items []string
+	for _, key := range d.keys {
+		items = append(items, fmt.Sprintf("(%s, %s)", key.Pretty(indent), d.entries[key.Pretty(0)].Pretty(indent)))
+	}
+	return dent(indent, fmt.Sprintf("Dict [%s]", strings.Join(items, ", ")))
+}
+
+func newDict() *DictVal {
+	return &DictVal{entries: map[string]Val{}}
+}
+
+// set sets key to val in place, so it is only used on dictionaries being
+// built.
+func (d *DictVal) set(key, val Val) {
+	id := key.Pretty(0)
+	if _, has := d.entries[id]; !has {
+		d.keys = append(d.keys, key)
+	}
+	d.entries[id] = val
+}
+
+func (d *DictVal) get(key Val) (Val, bool) {
+	val, has := d.entries[key.Pretty(0)]
+	return val, has
+}
+
+func (d *DictVal) clone() *DictVal {
+	clone := newDict()
+	for _, key := range d.keys {
+		val, _ := d.get(key)
+		clone.set(key, val)
+	}
+	return clone
+}
+
+func dictArg(arg Val) (*DictVal, error) {
+	dict, ok := arg.(*DictVal)
+	if !ok {
+		return nil, errors.Errorf("invalid dict type %t", arg)
+	}
+	return dict, nil
+}
+
+func dictItems() map[string]Item {
+	k, v := &TypeVar{Name: "k"}, &TypeVar{Name: "v"}
+	optionType := &TypeRec{
+		Entries: map[string]Type{"Some": v, "None": unitType},
+		RestVar: nil,
+		Union:   true,
+	}
+
+	return map[string]Item{
+		"dict_from_list": {
+			Type: &Scheme{
+				Forall: []string{"k", "v"},
+				Type:   lamType(listType(tupleType(k, v)), dictType(k, v)),
+			},
+			Val: pureBuiltin("dict_from_list", 1, func(args []Val) (Val, error) {
+				list, err := listArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				dict := newDict()
+				for _, item := range list.Items {
+					pair, ok := item.(*TupleVal)
+					if !ok || len(pair.Items) != 2 {
+						return nil, errors.Errorf("invalid pair type %t", item)
+					}
+					dict.set(pair.Items[0], pair.Items[1])
+				}
+				return dict, nil
+			}),
+		},
+		"dict_to_list": {
+			Type: &Scheme{
+				Forall: []string{"k", "v"},
+				Type:   lamType(dictType(k, v), listType(tupleType(k, v))),
+			},
+			Val: pureBuiltin("dict_to_list", 1, func(args []Val) (Val, error) {
+				dict, err := dictArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				var items []Val
+				for _, key := range dict.keys {
+					val, _ := dict.get(key)
+					items = append(items, &TupleVal{Items: []Val{key, val}})
+				}
+				return &ListVal{Items: items}, nil
+			}),
+		},
+		"dict_get": {
+			Type: &Scheme{
+				Forall: []string{"k", "v"},
+				Type:   lamType(dictType(k, v), k, optionType),
+			},
+			Val: pureBuiltin("dict_get", 2, func(args []Val) (Val, error) {
+				dict, err := dictArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				val, has := dict.get(args[1])
+				if !has {
+					return &ConsVal{Name: "None", Payload: unitVal}, nil
+				}
+				return &ConsVal{Name: "Some", Payload: val}, nil
+			}),
+		},
+		"dict_set": {
+			Type: &Scheme{
+				Forall: []string{"k", "v"},
+				Type:   lamType(dictType(k, v), k, v, dictType(k, v)),
+			},
+			Val: pureBuiltin("dict_set", 3, func(args []Val) (Val, error) {
+				dict, err := dictArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				dict = dict.clone()
+				dict.set(args[1], args[2])
+				return dict, nil
+			}),
+		},
+	}
+}
@@ -1,8 +1,12 @@
 package internal
 
 import (
+	"bufio"
+	"fmt"
+	"io"
 	"maps"
 	"os"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -49,8 +53,100 @@ func lamType(args ...Type) *TypeCons {
 	}
 }
 
+func listType(item Type) *TypeCons {
+	return &TypeCons{
+		Name: listConsName,
+		Args: []Type{item},
+	}
+}
+
+func tupleType(items ...Type) *TypeCons {
+	return &TypeCons{
+		Name: tupleConsName,
+		Args: items,
+	}
+}
+
+// unionType is an open union with the given constructors and rest.
+func unionType(entries map[string]Type, rest string) *TypeRec {
+	return &TypeRec{
+		Entries: entries,
+		RestVar: &TypeVar{Name: rest},
+		Union:   true,
+	}
+}
+
+func strList(items []string) *ListVal {
+	list := &ListVal{}
+	for _, item := range items {
+		list.Items = append(list.Items, &LitStr{Value: item})
+	}
+	return list
+}
+
+func envVars(e *Evaluator) (Val, error) {
+	vars := &ListVal{}
+	for _, entry := range os.Environ() {
+		name, value, _ := strings.Cut(entry, "=")
+		vars.Items = append(vars.Items, &TupleVal{Items: []Val{&LitStr{Value: name}, &LitStr{Value: value}}})
+	}
+	return vars, nil
+}
+
+// printBuiltin writes its argument and a line break to the writer out picks
+// from the program.
+func printBuiltin(name string, program *Program, out func(p *Program) io.Writer) *Builtin {
+	return &Builtin{
+		Name: name,
+		Impl: func(e *Evaluator, args []Val) (Val, error) {
+			if len(args) != 1 {
+				return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+			}
+			text, ok := args[0].(*LitStr)
+			if !ok {
+				return nil, errors.Errorf("invalid text type %t", args[0])
+			}
+
+			return thunk(name, func(e *Evaluator) (Val, error) {
+				_, err := fmt.Fprintln(out(program), text.Value)
+				if err != nil {
+					return nil, taskErrorf("%s", err.Error())
+				}
+				return unitVal, nil
+			}), nil
+		},
+	}
+}
+
+// thunk wraps run as a task value, which EvalTask or flat_map execute by
+// calling it without arguments.
+func thunk(name string, run func(e *Evaluator) (Val, error)) *Builtin {
+	return &Builtin{
+		Name: name + "_thunk",
+		Impl: func(e *Evaluator, args []Val) (Val, error) {
+			return run(e)
+		},
+	}
+}
+
+// catchTask runs task and hands a TaskError it fails with to handle. Other
+// errors are failures of the evaluator itself and are passed through.
+func catchTask(e *Evaluator, task Val, handle func(taskErr *TaskError) (Val, error)) (Val, error) {
+	res, err := e.evalFn(task, nil)
+	if err == nil {
+		return res, nil
+	}
+
+	var taskErr *TaskError
+	if !errors.As(err, &taskErr) {
+		return nil, err
+	}
+
+	return handle(taskErr)
+}
+
 func NewStdEnv(program *Program) *Env {
-	return &Env{
+	env := &Env{
 		Items: map[string]Item{
 			"+": {
 				Type: &Scheme{
@@ -155,7 +251,7 @@ func NewStdEnv(program *Program) *Env {
 
 						arg1 := args[0]
 						arg2 := args[1]
-						if arg1.Pretty(0) == arg2.Pretty(0) {
+						if equal(arg1, arg2) {
 							return trueVal, nil
 						} else {
 							return falseVal, nil
@@ -193,7 +289,7 @@ func NewStdEnv(program *Program) *Env {
 						}
 
 						newEnv := maps.Clone(cont.Env)
-						result, err := program.evaluator.Eval(cont.Body, newEnv)
+						result, err := e.Eval(cont.Body, newEnv)
 						newEnv[cont.Params[0]] = result
 						return result, err
 					},
@@ -307,12 +403,316 @@ func NewStdEnv(program *Program) *Env {
 						return &Builtin{
 							Name: "err_thunk",
 							Impl: func(e *Evaluator, args []Val) (Val, error) {
-								return nil, errors.Errorf(str.Value)
+								return nil, taskErrorf("%s", str.Value)
 							},
 						}, nil
 					},
 				},
 			},
+			"fail": {
+				Type: &Scheme{
+					Forall: []string{"a", "e"},
+					Type: lamType(
+						&TypeRec{
+							Entries: map[string]Type{},
+							RestVar: &TypeVar{Name: "e"},
+							Union:   true,
+						},
+						taskType(&TypeVar{Name: "a"}, &TypeRec{
+							Entries: map[string]Type{},
+							RestVar: &TypeVar{Name: "e"},
+							Union:   true,
+						}),
+					),
+				},
+				Val: &Builtin{
+					Name: "fail",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						if len(args) != 1 {
+							return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+						}
+						payload := args[0]
+						return thunk("fail", func(e *Evaluator) (Val, error) {
+							return nil, &TaskError{Val: payload}
+						}), nil
+					},
+				},
+			},
+			"list": {
+				Type: &Scheme{
+					Forall: []string{"a", "b"},
+					Type: &TypeRec{
+						Entries: map[string]Type{
+							"flat_map": lamType(
+								listType(&TypeVar{Name: "a"}),
+								lamType(&TypeVar{Name: "a"}, listType(&TypeVar{Name: "b"})),
+								listType(&TypeVar{Name: "b"}),
+							),
+							"ok": lamType(&TypeVar{Name: "a"}, listType(&TypeVar{Name: "a"})),
+						},
+						RestVar: nil,
+						Union:   false,
+					},
+				},
+				Val: &RecVal{
+					Entries: map[string]Val{
+						"flat_map": &Builtin{
+							Name: "list.flat_map",
+							Impl: func(e *Evaluator, args []Val) (Val, error) {
+								if len(args) != 2 {
+									return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+								}
+								list, ok := args[0].(*ListVal)
+								if !ok {
+									return nil, errors.Errorf("invalid list type %T", args[0])
+								}
+
+								result := &ListVal{}
+								for _, item := range list.Items {
+									out, err := e.evalFn(args[1], []Val{item})
+									if err != nil {
+										return nil, err
+									}
+									items, ok := out.(*ListVal)
+									if !ok {
+										return nil, errors.Errorf("invalid list type %T", out)
+									}
+									result.Items = append(result.Items, items.Items...)
+								}
+								return result, nil
+							},
+						},
+						"ok": &Builtin{
+							Name: "list.ok",
+							Impl: func(e *Evaluator, args []Val) (Val, error) {
+								if len(args) != 1 {
+									return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+								}
+								return &ListVal{Items: []Val{args[0]}}, nil
+							},
+						},
+					},
+				},
+			},
+			"zip": {
+				Type: &Scheme{
+					Forall: []string{"a", "b"},
+					Type: lamType(
+						listType(&TypeVar{Name: "a"}),
+						listType(&TypeVar{Name: "b"}),
+						listType(tupleType(&TypeVar{Name: "a"}, &TypeVar{Name: "b"})),
+					),
+				},
+				Val: &Builtin{
+					Name: "zip",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						if len(args) != 2 {
+							return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+						}
+						as, ok := args[0].(*ListVal)
+						if !ok {
+							return nil, errors.Errorf("invalid list type %t", args[0])
+						}
+						bs, ok := args[1].(*ListVal)
+						if !ok {
+							return nil, errors.Errorf("invalid list type %t", args[1])
+						}
+
+						var items []Val
+						for i := range min(len(as.Items), len(bs.Items)) {
+							items = append(items, &TupleVal{Items: []Val{as.Items[i], bs.Items[i]}})
+						}
+						return &ListVal{Items: items}, nil
+					},
+				},
+			},
+			"unzip": {
+				Type: &Scheme{
+					Forall: []string{"a", "b"},
+					Type: lamType(
+						listType(tupleType(&TypeVar{Name: "a"}, &TypeVar{Name: "b"})),
+						tupleType(listType(&TypeVar{Name: "a"}), listType(&TypeVar{Name: "b"})),
+					),
+				},
+				Val: &Builtin{
+					Name: "unzip",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						if len(args) != 1 {
+							return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+						}
+						pairs, ok := args[0].(*ListVal)
+						if !ok {
+							return nil, errors.Errorf("invalid list type %t", args[0])
+						}
+
+						as := &ListVal{}
+						bs := &ListVal{}
+						for _, item := range pairs.Items {
+							pair, ok := item.(*TupleVal)
+							if !ok || len(pair.Items) != 2 {
+								return nil, errors.Errorf("invalid pair type %t", item)
+							}
+							as.Items = append(as.Items, pair.Items[0])
+							bs.Items = append(bs.Items, pair.Items[1])
+						}
+						return &TupleVal{Items: []Val{as, bs}}, nil
+					},
+				},
+			},
+			"catch": {
+				Type: &Scheme{
+					Forall: []string{"a", "e", "f"},
+					Type: lamType(
+						taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+						lamType(&TypeVar{Name: "e"}, taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "f"})),
+						taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "f"}),
+					),
+				},
+				Val: &Builtin{
+					Name: "catch",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						if len(args) != 2 {
+							return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+						}
+						task := args[0]
+						handler := args[1]
+						return thunk("catch", func(e *Evaluator) (Val, error) {
+							return catchTask(e, task, func(taskErr *TaskError) (Val, error) {
+								recovery, err := e.evalFn(handler, []Val{taskErr.Val})
+								if err != nil {
+									return nil, err
+								}
+								return e.evalFn(recovery, nil)
+							})
+						}), nil
+					},
+				},
+			},
+			"recover": {
+				Type: &Scheme{
+					Forall: []string{"a", "e"},
+					Type: lamType(
+						taskType(&TypeVar{Name: "a"}, &TypeRec{
+							Entries: map[string]Type{"Err": &TypeCons{
+								Name: strConsName,
+								Args: nil,
+							}},
+							RestVar: &TypeVar{Name: "e"},
+							Union:   true,
+						}),
+						lamType(
+							&TypeCons{
+								Name: strConsName,
+								Args: nil,
+							},
+							taskType(&TypeVar{Name: "a"}, &TypeRec{
+								Entries: map[string]Type{},
+								RestVar: &TypeVar{Name: "e"},
+								Union:   true,
+							}),
+						),
+						taskType(&TypeVar{Name: "a"}, &TypeRec{
+							Entries: map[string]Type{},
+							RestVar: &TypeVar{Name: "e"},
+							Union:   true,
+						}),
+					),
+				},
+				Val: &Builtin{
+					Name: "recover",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						if len(args) != 2 {
+							return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+						}
+						task := args[0]
+						handler := args[1]
+						return thunk("recover", func(e *Evaluator) (Val, error) {
+							return catchTask(e, task, func(taskErr *TaskError) (Val, error) {
+								cons, ok := taskErr.Val.(*ConsVal)
+								if !ok || cons.Name != "Err" {
+									return nil, taskErr
+								}
+
+								recovery, err := e.evalFn(handler, []Val{cons.Payload})
+								if err != nil {
+									return nil, err
+								}
+								return e.evalFn(recovery, nil)
+							})
+						}), nil
+					},
+				},
+			},
+			"map_err": {
+				Type: &Scheme{
+					Forall: []string{"a", "e", "f"},
+					Type: lamType(
+						taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+						lamType(&TypeVar{Name: "e"}, &TypeVar{Name: "f"}),
+						taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "f"}),
+					),
+				},
+				Val: &Builtin{
+					Name: "map_err",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						if len(args) != 2 {
+							return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+						}
+						task := args[0]
+						mapper := args[1]
+						return thunk("map_err", func(e *Evaluator) (Val, error) {
+							return catchTask(e, task, func(taskErr *TaskError) (Val, error) {
+								mapped, err := e.evalFn(mapper, []Val{taskErr.Val})
+								if err != nil {
+									return nil, err
+								}
+								return nil, &TaskError{Val: mapped}
+							})
+						}), nil
+					},
+				},
+			},
+			"attempt": {
+				Type: &Scheme{
+					Forall: []string{"a", "e"},
+					Type: lamType(
+						taskType(&TypeVar{Name: "a"}, &TypeRec{
+							Entries: map[string]Type{},
+							RestVar: &TypeVar{Name: "e"},
+							Union:   true,
+						}),
+						taskType(
+							&TypeRec{
+								Entries: map[string]Type{"Ok": &TypeVar{Name: "a"}},
+								RestVar: &TypeVar{Name: "e"},
+								Union:   true,
+							},
+							neverType,
+						),
+					),
+				},
+				Val: &Builtin{
+					Name: "attempt",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						if len(args) != 1 {
+							return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+						}
+						task := args[0]
+						return thunk("attempt", func(e *Evaluator) (Val, error) {
+							res, err := e.evalFn(task, nil)
+							if err == nil {
+								return &ConsVal{Name: "Ok", Payload: res}, nil
+							}
+
+							var taskErr *TaskError
+							if !errors.As(err, &taskErr) {
+								return nil, err
+							}
+							return taskErr.Val, nil
+						}), nil
+					},
+				},
+			},
 			"write": {
 				Type: &Scheme{
 					Forall: []string{"rest"},
@@ -360,9 +760,9 @@ func NewStdEnv(program *Program) *Env {
 						return &Builtin{
 							Name: "write_thunk",
 							Impl: func(e *Evaluator, args []Val) (Val, error) {
-								err := os.WriteFile(filename.Value, []byte(content.Value), 0644)
+								err := os.WriteFile(e.path(filename.Value), []byte(content.Value), 0644)
 								if err != nil {
-									return nil, err
+									return nil, taskErrorf("%s", err.Error())
 								}
 								return unitVal, nil
 							},
@@ -370,6 +770,136 @@ func NewStdEnv(program *Program) *Env {
 					},
 				},
 			},
+			"print": {
+				Type: &Scheme{
+					Forall: []string{"e"},
+					Type:   lamType(strType, taskType(unitType, unionType(map[string]Type{"Err": strType}, "e"))),
+				},
+				Val: printBuiltin("print", program, func(p *Program) io.Writer { return p.Stdout }),
+			},
+			"eprint": {
+				Type: &Scheme{
+					Forall: []string{"e"},
+					Type:   lamType(strType, taskType(unitType, unionType(map[string]Type{"Err": strType}, "e"))),
+				},
+				Val: printBuiltin("eprint", program, func(p *Program) io.Writer { return p.Stderr }),
+			},
+			"read_line": {
+				Type: &Scheme{
+					Forall: []string{"e"},
+					Type:   taskType(strType, unionType(map[string]Type{"Eof": unitType, "Err": strType}, "e")),
+				},
+				Val: thunk("read_line", func(e *Evaluator) (Val, error) {
+					return program.readStdin(func(r *bufio.Reader) (Val, error) {
+						line, err := r.ReadString('\n')
+						if err == io.EOF && line == "" {
+							return nil, &TaskError{Val: &ConsVal{Name: "Eof", Payload: unitVal}}
+						}
+						if err != nil && err != io.EOF {
+							return nil, taskErrorf("%s", err.Error())
+						}
+						line = strings.TrimSuffix(line, "\n")
+						line = strings.TrimSuffix(line, "\r")
+						return &LitStr{Value: line}, nil
+					})
+				}),
+			},
+			"read_all": {
+				Type: &Scheme{
+					Forall: []string{"e"},
+					Type:   taskType(strType, unionType(map[string]Type{"Err": strType}, "e")),
+				},
+				Val: thunk("read_all", func(e *Evaluator) (Val, error) {
+					return program.readStdin(func(r *bufio.Reader) (Val, error) {
+						content, err := io.ReadAll(r)
+						if err != nil {
+							return nil, taskErrorf("%s", err.Error())
+						}
+						return &LitStr{Value: string(content)}, nil
+					})
+				}),
+			},
+			"get_env": {
+				Type: &Scheme{
+					Forall: []string{"e"},
+					Type: lamType(strType, taskType(&TypeRec{
+						Entries: map[string]Type{"Some": strType, "None": unitType},
+						RestVar: nil,
+						Union:   true,
+					}, unionType(map[string]Type{}, "e"))),
+				},
+				Val: &Builtin{
+					Name: "get_env",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						if len(args) != 1 {
+							return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+						}
+						name, ok := args[0].(*LitStr)
+						if !ok {
+							return nil, errors.Errorf("invalid name type %t", args[0])
+						}
+
+						return thunk("get_env", func(e *Evaluator) (Val, error) {
+							value, has := os.LookupEnv(name.Value)
+							if !has {
+								return &ConsVal{Name: "None", Payload: unitVal}, nil
+							}
+							return &ConsVal{Name: "Some", Payload: &LitStr{Value: value}}, nil
+						}), nil
+					},
+				},
+			},
+			"env_vars": {
+				Type: &Scheme{
+					Forall: []string{"e"},
+					Type:   lamType(taskType(listType(tupleType(strType, strType)), unionType(map[string]Type{}, "e"))),
+				},
+				Val: &Builtin{
+					Name: "env_vars",
+					Impl: func(e *Evaluator, args []Val) (Val, error) {
+						return thunk("env_vars", envVars), nil
+					},
+				},
+			},
+			"args": {
+				Type: &Scheme{
+					Forall: []string{"e"},
+					Type: taskType(listType(strType), &TypeRec{
+						Entries: map[string]Type{},
+						RestVar: &TypeVar{Name: "e"},
+						Union:   true,
+					}),
+				},
+				Val: thunk("args", func(e *Evaluator) (Val, error) {
+					return strList(program.Args), nil
+				}),
+			},
+			"env": {
+				Type: &Scheme{
+					Forall: []string{"e"},
+					Type: taskType(listType(tupleType(strType, strType)), &TypeRec{
+						Entries: map[string]Type{},
+						RestVar: &TypeVar{Name: "e"},
+						Union:   true,
+					}),
+				},
+				Val: thunk("env", envVars),
+			},
 		},
 	}
+	maps.Copy(env.Items, fsItems())
+	maps.Copy(env.Items, processItems())
+	maps.Copy(env.Items, parItems())
+	maps.Copy(env.Items, chanItems())
+	maps.Copy(env.Items, refItems())
+	maps.Copy(env.Items, retryItems())
+	maps.Copy(env.Items, timeItems())
+	maps.Copy(env.Items, floatItems())
+	maps.Copy(env.Items, randomItems())
+	maps.Copy(env.Items, jsonItems())
+	maps.Copy(env.Items, dictItems())
+	maps.Copy(env.Items, csvItems())
+	maps.Copy(env.Items, regexItems())
+	maps.Copy(env.Items, hashItems())
+	return env
 }
@@ -2,8 +2,11 @@ package internal
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
 	"github.com/scylladb/go-set/strset"
@@ -22,6 +25,7 @@ type Expr interface {
 func (i *Int) expr()    {}
 func (s *LitStr) expr() {}
 func (s *Str) expr()    {}
+func (f *Format) expr() {}
 func (v *Var) expr()    {}
 func (a *App) expr()    {}
 func (l *Lam) expr()    {}
@@ -30,6 +34,7 @@ func (p *Prop) expr()   {}
 func (c *Cons) expr()   {}
 func (w *When) expr()   {}
 func (l *List) expr()   {}
+func (t *Tuple) expr()  {}
 func (b *Block) expr()  {}
 
 type Int struct {
@@ -45,7 +50,7 @@ type LitStr struct {
 }
 
 func (s *LitStr) Pretty(indent int) string {
-	return "`" + dent(indent, s.Value) + "`"
+	return dent(indent, "`"+escape(s.Value)+"`")
 }
 
 type Str struct {
@@ -55,15 +60,125 @@ type Str struct {
 func (s *Str) Pretty(indent int) string {
 	var parts []string
 	for _, part := range s.Parts {
-		parts = append(parts, part.Pretty(0))
+		if lit, ok := part.(*LitStr); ok {
+			parts = append(parts, escape(lit.Value))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("{%s}", part.Pretty(0)))
 	}
 
 	return dent(indent, fmt.Sprintf("`%s`", strings.Join(parts, "")))
 }
 
+// Format is a string template part with a format specifier, as in `{n:04}`.
+// It only appears in Str.Parts.
+type Format struct {
+	Value Expr
+	Spec  *FormatSpec
+}
+
+func (f *Format) Pretty(indent int) string {
+	return dent(indent, fmt.Sprintf("%s:%s", f.Value.Pretty(0), f.Spec.Raw))
+}
+
+// FormatSpec is a printf-like specifier: an optional `-` to align left, an
+// optional `0` to pad with zeros, a width, a precision and a verb out of
+// d, x, X, o, b (integers), f, e, g (floats) and s.
+type FormatSpec struct {
+	Raw       string
+	Left      bool
+	Zero      bool
+	Width     string
+	Precision string
+	Verb      byte
+}
+
+var formatSpecRegexp = regexp.MustCompile(`^(-?)(0?)(\d*)(\.\d+)?([dxXobefgs]?)$`)
+
+func parseFormatSpec(raw string) (*FormatSpec, error) {
+	match := formatSpecRegexp.FindStringSubmatch(raw)
+	if match == nil {
+		return nil, errors.Errorf("invalid format specifier: %s", raw)
+	}
+
+	spec := &FormatSpec{
+		Raw:       raw,
+		Left:      match[1] != "",
+		Zero:      match[2] != "",
+		Width:     match[3],
+		Precision: match[4],
+	}
+	if match[5] != "" {
+		spec.Verb = match[5][0]
+	}
+	return spec, nil
+}
+
+// argType is the type the verb of f takes, or nil if it takes any value.
+func (f *FormatSpec) argType() Type {
+	switch f.Verb {
+	case 'd', 'x', 'X', 'o', 'b':
+		return intType
+	case 'f', 'e', 'g':
+		return floatType
+	default:
+		return nil
+	}
+}
+
+func (f *FormatSpec) format(val Val) (string, error) {
+	verb := f.Verb
+	if verb == 0 {
+		switch val.(type) {
+		case *Int:
+			verb = 'd'
+		case *FloatVal:
+			if f.Precision != "" {
+				verb = 'f'
+			} else {
+				verb = 'v'
+			}
+		default:
+			verb = 's'
+		}
+	}
+
+	var arg any
+	switch verb {
+	case 's':
+		arg = show(val)
+	case 'd', 'x', 'X', 'o', 'b':
+		i, ok := val.(*Int)
+		if !ok {
+			return "", errors.Errorf("invalid value type for format specifier %s: %T", f.Raw, val)
+		}
+		arg = i.Value
+	default:
+		float, ok := val.(*FloatVal)
+		if !ok {
+			return "", errors.Errorf("invalid value type for format specifier %s: %T", f.Raw, val)
+		}
+		arg = float.Value
+	}
+
+	directive := "%"
+	if f.Left {
+		directive += "-"
+	}
+	if f.Zero {
+		directive += "0"
+	}
+	directive += f.Width + f.Precision + string(verb)
+
+	return fmt.Sprintf(directive, arg), nil
+}
+
 type Var struct {
 	Name     string
 	IsSymbol bool
+	// TypeArg is set on uses of decode to the type they decode to, once the
+	// module's types are inferred
+	TypeArg Type
 }
 
 func (v *Var) Pretty(indent int) string {
@@ -181,12 +296,25 @@ func (l *List) Pretty(indent int) string {
 	return dent(indent, fmt.Sprintf("[%s]", strings.Join(items, ", ")))
 }
 
+type Tuple struct {
+	Items []Expr
+}
+
+func (t *Tuple) Pretty(indent int) string {
+	var items []string
+	for _, item := range t.Items {
+		items = append(items, item.Pretty(indent))
+	}
+	return dent(indent, fmt.Sprintf("(%s)", strings.Join(items, ", ")))
+}
+
 type Declaration interface {
 	Pretty(indent int) string
 	decl()
 }
 
 func (a *Assignment) decl()     {}
+func (d *Destructure) decl()    {}
 func (a *TypeAnnotation) decl() {}
 func (a *Import) decl()         {}
 
@@ -199,6 +327,17 @@ func (a *Assignment) Pretty(indent int) string {
 	return dent(indent, fmt.Sprintf("%s = %s", a.Name, a.Value.Pretty(indent)))
 }
 
+// Destructure binds the items of a tuple value to the variables of Pattern.
+// Pattern items are either *Var or nested *Tuple.
+type Destructure struct {
+	Pattern *Tuple
+	Value   Expr
+}
+
+func (d *Destructure) Pretty(indent int) string {
+	return dent(indent, fmt.Sprintf("%s = %s", d.Pattern.Pretty(0), d.Value.Pretty(indent)))
+}
+
 type TypeAnnotation struct {
 	Name   string
 	Scheme *Scheme
@@ -246,20 +385,62 @@ func fromNode(node *tree_sitter.Node, source []byte) (Expr, error) {
 		}
 		return &Int{Value: value}, nil
 	case "lit_str":
-		return &LitStr{Value: node.Utf8Text(source)}, nil
+		value, err := unescape(node.Utf8Text(source))
+		if err != nil {
+			return nil, err
+		}
+		return &LitStr{Value: value}, nil
 	case "str":
 		cursor := node.Walk()
 
+		// literal parts are kept raw until the template is dedented, with
+		// every interpolation standing in as a placeholder
+		var raw []string
 		var exprs []Expr
 		for _, child := range node.NamedChildren(cursor) {
+			if child.GrammarName() == "lit_str" {
+				raw = append(raw, child.Utf8Text(source))
+				continue
+			}
+
+			if child.GrammarName() == "fmt_spec" {
+				spec, err := parseFormatSpec(child.Utf8Text(source))
+				if err != nil {
+					return nil, err
+				}
+
+				exprs[len(exprs)-1] = &Format{Value: exprs[len(exprs)-1], Spec: spec}
+				continue
+			}
+
 			expr, err := fromNode(&child, source)
 			if err != nil {
 				return nil, err
 			}
+			raw = append(raw, placeholder)
 			exprs = append(exprs, expr)
 		}
 
-		return &Str{Parts: exprs}, nil
+		template := strings.Join(raw, "")
+		if strings.HasPrefix(template, "\n") {
+			template = dedent(template[1:])
+		}
+
+		var parts []Expr
+		for i, lit := range strings.Split(template, placeholder) {
+			if lit != "" {
+				value, err := unescape(lit)
+				if err != nil {
+					return nil, err
+				}
+				parts = append(parts, &LitStr{Value: value})
+			}
+			if i < len(exprs) {
+				parts = append(parts, exprs[i])
+			}
+		}
+
+		return &Str{Parts: parts}, nil
 	case "var":
 		name := node.Utf8Text(source)
 		return &Var{Name: name}, nil
@@ -267,11 +448,6 @@ func fromNode(node *tree_sitter.Node, source []byte) (Expr, error) {
 		name := node.Utf8Text(source)
 		return &Var{Name: name, IsSymbol: true}, nil
 	case "app":
-		first, err := fromNode(node.NamedChild(0), source)
-		if err != nil {
-			return nil, err
-		}
-
 		var args []Expr
 		for i := uint(1); i < node.NamedChildCount(); i++ {
 			child := node.NamedChild(i)
@@ -283,6 +459,16 @@ func fromNode(node *tree_sitter.Node, source []byte) (Expr, error) {
 			args = append(args, expr)
 		}
 
+		fnNode := node.NamedChild(0)
+		if fnNode.Kind() == "cons" && fnNode.NamedChildCount() == 1 {
+			return consAppFromNode(fnNode, args, source)
+		}
+
+		first, err := fromNode(fnNode, source)
+		if err != nil {
+			return nil, err
+		}
+
 		return &App{Fn: first, Args: args}, nil
 	case "iapp":
 		a, err := fromNode(node.NamedChild(0), source)
@@ -398,6 +584,9 @@ func fromNode(node *tree_sitter.Node, source []byte) (Expr, error) {
 		}, nil
 	case "cons":
 		consName := node.NamedChild(0).Utf8Text(source)
+		if node.NamedChildCount() < 2 {
+			return nil, errors.Errorf("constructor `%s` needs a payload", consName)
+		}
 		payload, err := fromNode(node.NamedChild(1), source)
 		if err != nil {
 			return nil, err
@@ -425,19 +614,32 @@ func fromNode(node *tree_sitter.Node, source []byte) (Expr, error) {
 			if err != nil {
 				return nil, err
 			}
-			v, ok := payloadVar.(*Var)
-			if !ok {
-				return nil, errors.Errorf("unexpected when payload expression type %t", payloadVar)
-			}
 
 			consequence, err := fromNode(node.NamedChild(i+2), source)
 			if err != nil {
 				return nil, err
 			}
 
+			var payload string
+			switch v := payloadVar.(type) {
+			case *Var:
+				payload = v.Name
+			case *Tuple:
+				if err := checkPattern(v); err != nil {
+					return nil, err
+				}
+				payload = tupleParam
+				consequence = &Block{
+					Decs:   []Declaration{&Destructure{Pattern: v, Value: &Var{Name: tupleParam}}},
+					Result: consequence,
+				}
+			default:
+				return nil, errors.Errorf("unexpected when payload expression type %t", payloadVar)
+			}
+
 			options = append(options, WhenClause{
 				ConsName:    cons,
-				Payload:     v.Name,
+				Payload:     payload,
 				Consequence: consequence,
 			})
 			i += 3
@@ -469,32 +671,84 @@ func fromNode(node *tree_sitter.Node, source []byte) (Expr, error) {
 			exprs = append(exprs, expr)
 		}
 		return &List{Items: exprs}, nil
+	case "tuple":
+		var exprs []Expr
+		for _, child := range node.NamedChildren(node.Walk()) {
+			expr, err := fromNode(&child, source)
+			if err != nil {
+				return nil, err
+			}
+			exprs = append(exprs, expr)
+		}
+		return &Tuple{Items: exprs}, nil
 	case "annot":
-	case "block", "source_file":
+	case "block", "source_file", "with":
+		// binds desugar into calls to flat_map and ok: the Task builtins, or
+		// the fields of the record a `with` block names
+		var flatMap Expr = &Var{Name: "flat_map"}
+		var ok Expr = &Var{Name: "ok"}
+		if node.GrammarName() == "with" {
+			count := node.NamedChildCount()
+			var monad Expr = &Var{Name: node.NamedChild(0).Utf8Text(source)}
+			for i := uint(1); i < count-1; i++ {
+				monad = &Prop{Parent: monad, Prop: node.NamedChild(i).Utf8Text(source)}
+			}
+			flatMap = &Prop{Parent: monad, Prop: "flat_map"}
+			ok = &Prop{Parent: monad, Prop: "ok"}
+			node = node.NamedChild(count - 1)
+		}
+
 		expr := &Block{}
 		block := expr
 		children := node.NamedChildren(node.Walk())
 		for _, child := range children[:len(children)-1] {
 			switch child.GrammarName() {
 			case "assign":
+				if child.NamedChild(0).GrammarName() == "tuple" {
+					destructure, err := destructureFromNode(&child, source)
+					if err != nil {
+						return nil, err
+					}
+					block.Decs = append(block.Decs, destructure)
+					continue
+				}
+
 				assign, err := assignFromNode(&child, source)
 				if err != nil {
 					return nil, err
 				}
 				block.Decs = append(block.Decs, assign)
 			case "bind":
-				assign, err := assignFromNode(&child, source)
-				if err != nil {
-					return nil, err
+				newBlock := &Block{}
+
+				var param string
+				var value Expr
+				if child.NamedChild(0).GrammarName() == "tuple" {
+					destructure, err := destructureFromNode(&child, source)
+					if err != nil {
+						return nil, err
+					}
+
+					param = tupleParam
+					value = destructure.Value
+					destructure.Value = &Var{Name: tupleParam}
+					newBlock.Decs = append(newBlock.Decs, destructure)
+				} else {
+					assign, err := assignFromNode(&child, source)
+					if err != nil {
+						return nil, err
+					}
+
+					param = assign.Name
+					value = assign.Value
 				}
 
-				newBlock := &Block{}
 				block.Result = &App{
-					Fn: &Var{Name: "flat_map"},
+					Fn: flatMap,
 					Args: []Expr{
-						assign.Value,
+						value,
 						&Lam{
-							Params: []string{assign.Name},
+							Params: []string{param},
 							Body:   newBlock,
 						},
 					},
@@ -523,7 +777,7 @@ func fromNode(node *tree_sitter.Node, source []byte) (Expr, error) {
 		}
 		if expr != block {
 			block.Result = &App{
-				Fn:   &Var{Name: "ok"},
+				Fn:   ok,
 				Args: []Expr{last},
 			}
 		} else {
@@ -556,6 +810,197 @@ func assignFromNode(node *tree_sitter.Node, source []byte) (*Assignment, error)
 	}, nil
 }
 
+// placeholder marks an interpolation in a raw string template. The lexer
+// never includes NUL in a literal, so it cannot clash with source text.
+const placeholder = "\x00"
+
+// dedent strips the indentation common to all non-blank lines of a
+// multi-line string, as well as a last line holding only the indentation of
+// the closing backtick.
+func dedent(str string) string {
+	lines := strings.Split(str, "\n")
+	if last := lines[len(lines)-1]; strings.TrimLeft(last, " \t") == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	var common string
+	first := true
+	for _, line := range lines {
+		trimmed := strings.TrimLeft(line, " \t")
+		if trimmed == "" {
+			continue
+		}
+
+		indent := line[:len(line)-len(trimmed)]
+		if first {
+			common = indent
+			first = false
+			continue
+		}
+		for !strings.HasPrefix(indent, common) {
+			common = common[:len(common)-1]
+		}
+	}
+
+	for i, line := range lines {
+		if strings.HasPrefix(line, common) {
+			lines[i] = line[len(common):]
+		} else {
+			lines[i] = strings.TrimLeft(line, " \t")
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// unescape decodes the escape sequences of a string literal.
+func unescape(raw string) (string, error) {
+	if !strings.ContainsRune(raw, '\\') {
+		return raw, nil
+	}
+
+	var sb strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '\\' {
+			sb.WriteByte(raw[i])
+			continue
+		}
+
+		i++
+		if i == len(raw) {
+			return "", errors.Errorf("unterminated escape sequence")
+		}
+
+		switch raw[i] {
+		case 'n':
+			sb.WriteByte('\n')
+		case 't':
+			sb.WriteByte('\t')
+		case 'r':
+			sb.WriteByte('\r')
+		case '0':
+			sb.WriteByte(0)
+		case '\\', '`', '{', '}':
+			sb.WriteByte(raw[i])
+		case 'u':
+			end := strings.IndexByte(raw[i:], '}')
+			if !strings.HasPrefix(raw[i:], "u{") || end < 0 {
+				return "", errors.Errorf("invalid unicode escape sequence")
+			}
+
+			code, err := strconv.ParseUint(raw[i+2:i+end], 16, 32)
+			if err != nil || !utf8.ValidRune(rune(code)) {
+				return "", errors.Errorf("invalid unicode code point \\%s", raw[i:i+end+1])
+			}
+
+			sb.WriteRune(rune(code))
+			i += end
+		default:
+			return "", errors.Errorf("invalid escape sequence \\%c", raw[i])
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// escape is the inverse of unescape, producing the body of a string literal.
+func escape(str string) string {
+	var sb strings.Builder
+	for _, r := range str {
+		switch r {
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\\', '`', '{', '}':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		default:
+			if unicode.IsControl(r) {
+				sb.WriteString(fmt.Sprintf("\\u{%X}", r))
+				continue
+			}
+			sb.WriteRune(r)
+		}
+	}
+
+	return sb.String()
+}
+
+// tupleParam names the hidden variable holding a tuple before it is
+// destructured. It is not a valid variable name in source code.
+const tupleParam = "$tuple"
+
+func destructureFromNode(node *tree_sitter.Node, source []byte) (*Destructure, error) {
+	lhs, err := fromNode(node.NamedChild(0), source)
+	if err != nil {
+		return nil, err
+	}
+
+	pattern, ok := lhs.(*Tuple)
+	if !ok {
+		return nil, errors.Errorf("unexpected lhs expression type %t", lhs)
+	}
+	if err := checkPattern(pattern); err != nil {
+		return nil, err
+	}
+
+	rhs, err := fromNode(node.NamedChild(1), source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Destructure{
+		Pattern: pattern,
+		Value:   rhs,
+	}, nil
+}
+
+func checkPattern(pattern *Tuple) error {
+	names := strset.New()
+	var check func(pattern *Tuple) error
+	check = func(pattern *Tuple) error {
+		for _, item := range pattern.Items {
+			switch item := item.(type) {
+			case *Var:
+				if item.IsSymbol {
+					return errors.Errorf("unexpected symbol in tuple pattern: %s", item.Name)
+				}
+				if names.Has(item.Name) {
+					return errors.Errorf("duplicate tuple pattern name: %s", item.Name)
+				}
+				names.Add(item.Name)
+			case *Tuple:
+				if err := check(item); err != nil {
+					return err
+				}
+			default:
+				return errors.Errorf("unexpected tuple pattern expression type %T", item)
+			}
+		}
+		return nil
+	}
+
+	return check(pattern)
+}
+
+// consAppFromNode builds a constructor applied to arguments. The grammar
+// reduces a bare constructor before a parenthesis, so `Pair (a, b)` parses as
+// an application; it is the constructor with a tuple payload.
+func consAppFromNode(node *tree_sitter.Node, args []Expr, source []byte) (Expr, error) {
+	consName := node.NamedChild(0).Utf8Text(source)
+	switch len(args) {
+	case 0:
+		return nil, errors.Errorf("constructor `%s` needs a payload", consName)
+	case 1:
+		return &Cons{Name: consName, Payload: args[0]}, nil
+	default:
+		return &Cons{Name: consName, Payload: &Tuple{Items: args}}, nil
+	}
+}
+
 func annotFromNode(node *tree_sitter.Node, source []byte) (*TypeAnnotation, error) {
 	lhs, err := fromNode(node.NamedChild(0), source)
 	if err != nil {
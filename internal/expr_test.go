@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"context"
+	"testing"
+)
+
+// evalString returns the string a module evaluates to.
+func evalString(t *testing.T, source string) string {
+	t.Helper()
+
+	program, err := NewProgram()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := program.Run(context.Background(), []byte(source), InlineModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	str, ok := mod.Val.(*LitStr)
+	if !ok {
+		t.Fatalf("got %s, want a string", mod.Val.Pretty(0))
+	}
+	return str.Value
+}
+
+func TestMultiLineStringDedent(t *testing.T) {
+	got := evalString(t, "`\n    usage: tool <file>\n      -v  verbose\n    `")
+	if want := "usage: tool <file>\n  -v  verbose"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestMultiLineStringInterpolated(t *testing.T) {
+	got := evalString(t, "n = 3\n`\n  count: {n}\n    done\n  `")
+	if want := "count: 3\n  done"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestEscapedLineBreakIsNotDedented(t *testing.T) {
+	got := evalString(t, "`\\n    indented`")
+	if want := "\n    indented"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestSingleLineStringIsNotDedented(t *testing.T) {
+	got := evalString(t, "`  padded\n  next`")
+	if want := "  padded\n  next"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
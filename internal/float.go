@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+const floatConsName = "Float"
+
+var floatType = &TypeCons{
+	Name: floatConsName,
+	Args: nil,
+}
+
+type FloatVal struct {
+	Value float64
+}
+
+func (f *FloatVal) val() {}
+
+func (f *FloatVal) Pretty(indent int) string {
+	return dent(indent, strconv.FormatFloat(f.Value, 'g', -1, 64))
+}
+
+func floatArg(arg Val) (*FloatVal, error) {
+	f, ok := arg.(*FloatVal)
+	if !ok {
+		return nil, errors.Errorf("invalid float type %t", arg)
+	}
+	return f, nil
+}
+
+func floatItems() map[string]Item {
+	return map[string]Item{
+		"to_float": {
+			Type: &Scheme{Forall: nil, Type: lamType(intType, floatType)},
+			Val: pureBuiltin("to_float", 1, func(args []Val) (Val, error) {
+				n, err := intArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return &FloatVal{Value: float64(n.Value)}, nil
+			}),
+		},
+		"truncate": {
+			Type: &Scheme{Forall: nil, Type: lamType(floatType, intType)},
+			Val: pureBuiltin("truncate", 1, func(args []Val) (Val, error) {
+				f, err := floatArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return &Int{Value: int(f.Value)}, nil
+			}),
+		},
+	}
+}
@@ -0,0 +1,348 @@
+package internal
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// fsErrorType is the error row of file-system tasks.
+func fsErrorType(rest string) *TypeRec {
+	return unionType(map[string]Type{
+		"NotFound":         strType,
+		"PermissionDenied": strType,
+		"IoError":          strType,
+	}, rest)
+}
+
+// fsError turns the error of a file-system call into the task failure
+// matching fsErrorType. path is reported unless the error names its own.
+func fsError(err error, path string) *TaskError {
+	var pathErr *fs.PathError
+	if errors.As(err, &pathErr) {
+		path = pathErr.Path
+	}
+
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return &TaskError{Val: &ConsVal{Name: "NotFound", Payload: &LitStr{Value: path}}}
+	case errors.Is(err, fs.ErrPermission):
+		return &TaskError{Val: &ConsVal{Name: "PermissionDenied", Payload: &LitStr{Value: path}}}
+	default:
+		return &TaskError{Val: &ConsVal{Name: "IoError", Payload: &LitStr{Value: err.Error()}}}
+	}
+}
+
+// fsBuiltin makes a builtin taking strings, the first of them a path, and
+// returning a task that runs run on them. Paths are resolved with e.path.
+// Failures of run are reported with fsError.
+func fsBuiltin(name string, run func(e *Evaluator, args []string) (Val, error)) *Builtin {
+	return &Builtin{
+		Name: name,
+		Impl: func(e *Evaluator, args []Val) (Val, error) {
+			var strs []string
+			for _, arg := range args {
+				str, ok := arg.(*LitStr)
+				if !ok {
+					return nil, errors.Errorf("invalid argument type %t", arg)
+				}
+				strs = append(strs, str.Value)
+			}
+
+			return thunk(name, func(e *Evaluator) (Val, error) {
+				res, err := run(e, strs)
+				if err != nil {
+					return nil, fsError(err, strs[0])
+				}
+				return res, nil
+			}), nil
+		},
+	}
+}
+
+var statType = &TypeRec{
+	Entries: map[string]Type{
+		"name":     strType,
+		"size":     intType,
+		"is_dir":   boolType,
+		"mode":     intType,
+		"modified": intType,
+	},
+	RestVar: nil,
+	Union:   false,
+}
+
+func boolVal(b bool) Val {
+	if b {
+		return trueVal
+	}
+	return falseVal
+}
+
+func fsItems() map[string]Item {
+	return map[string]Item{
+		"read_file": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, taskType(strType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("read_file", func(e *Evaluator, args []string) (Val, error) {
+				content, err := os.ReadFile(e.path(args[0]))
+				if err != nil {
+					return nil, err
+				}
+				return &LitStr{Value: string(content)}, nil
+			}),
+		},
+		"read_bytes": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, taskType(bytesType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("read_bytes", func(e *Evaluator, args []string) (Val, error) {
+				content, err := os.ReadFile(e.path(args[0]))
+				if err != nil {
+					return nil, err
+				}
+				return &BytesVal{Value: content}, nil
+			}),
+		},
+		"write_bytes": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, bytesType, taskType(unitType, fsErrorType("e"))),
+			},
+			Val: &Builtin{
+				Name: "write_bytes",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					path, ok := args[0].(*LitStr)
+					if !ok {
+						return nil, errors.Errorf("invalid filename type %t", args[0])
+					}
+					content, err := bytesArg(args[1])
+					if err != nil {
+						return nil, err
+					}
+
+					return thunk("write_bytes", func(e *Evaluator) (Val, error) {
+						if err := os.WriteFile(e.path(path.Value), content.Value, 0644); err != nil {
+							return nil, fsError(err, path.Value)
+						}
+						return unitVal, nil
+					}), nil
+				},
+			},
+		},
+		"append_file": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, strType, taskType(unitType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("append_file", func(e *Evaluator, args []string) (Val, error) {
+				file, err := os.OpenFile(e.path(args[0]), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+				if err != nil {
+					return nil, err
+				}
+				defer file.Close()
+
+				_, err = file.WriteString(args[1])
+				if err != nil {
+					return nil, err
+				}
+				return unitVal, file.Close()
+			}),
+		},
+		"exists": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, taskType(boolType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("exists", func(e *Evaluator, args []string) (Val, error) {
+				_, err := os.Stat(e.path(args[0]))
+				if errors.Is(err, fs.ErrNotExist) {
+					return falseVal, nil
+				}
+				if err != nil {
+					return nil, err
+				}
+				return trueVal, nil
+			}),
+		},
+		"stat": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, taskType(statType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("stat", func(e *Evaluator, args []string) (Val, error) {
+				info, err := os.Stat(e.path(args[0]))
+				if err != nil {
+					return nil, err
+				}
+				return &RecVal{Entries: map[string]Val{
+					"name":     &LitStr{Value: info.Name()},
+					"size":     &Int{Value: int(info.Size())},
+					"is_dir":   boolVal(info.IsDir()),
+					"mode":     &Int{Value: int(info.Mode().Perm())},
+					"modified": &Int{Value: int(info.ModTime().UnixMilli())},
+				}}, nil
+			}),
+		},
+		"list_dir": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, taskType(listType(strType), fsErrorType("e"))),
+			},
+			Val: fsBuiltin("list_dir", func(e *Evaluator, args []string) (Val, error) {
+				entries, err := os.ReadDir(e.path(args[0]))
+				if err != nil {
+					return nil, err
+				}
+
+				var names []string
+				for _, entry := range entries {
+					names = append(names, entry.Name())
+				}
+				return strList(names), nil
+			}),
+		},
+		"glob": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, taskType(listType(strType), fsErrorType("e"))),
+			},
+			Val: fsBuiltin("glob", func(e *Evaluator, args []string) (Val, error) {
+				matches, err := filepath.Glob(e.path(args[0]))
+				if err != nil {
+					return nil, err
+				}
+				// matches of a relative pattern stay relative to the working
+				// directory
+				if e.dir != "" && !filepath.IsAbs(args[0]) {
+					for i, match := range matches {
+						matches[i], _ = filepath.Rel(e.dir, match)
+					}
+				}
+				sort.Strings(matches)
+				return strList(matches), nil
+			}),
+		},
+		"make_dir": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, taskType(unitType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("make_dir", func(e *Evaluator, args []string) (Val, error) {
+				return unitVal, os.MkdirAll(e.path(args[0]), 0755)
+			}),
+		},
+		"remove": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, taskType(unitType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("remove", func(e *Evaluator, args []string) (Val, error) {
+				return unitVal, os.Remove(e.path(args[0]))
+			}),
+		},
+		"rename": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, strType, taskType(unitType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("rename", func(e *Evaluator, args []string) (Val, error) {
+				return unitVal, os.Rename(e.path(args[0]), e.path(args[1]))
+			}),
+		},
+		"copy_file": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(strType, strType, taskType(unitType, fsErrorType("e"))),
+			},
+			Val: fsBuiltin("copy_file", func(e *Evaluator, args []string) (Val, error) {
+				src, err := os.Open(e.path(args[0]))
+				if err != nil {
+					return nil, err
+				}
+				defer src.Close()
+
+				dst, err := os.Create(e.path(args[1]))
+				if err != nil {
+					return nil, err
+				}
+				defer dst.Close()
+
+				_, err = io.Copy(dst, src)
+				if err != nil {
+					return nil, err
+				}
+				return unitVal, dst.Close()
+			}),
+		},
+		"cwd": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(taskType(strType, fsErrorType("e"))),
+			},
+			Val: &Builtin{
+				Name: "cwd",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					return thunk("cwd", func(e *Evaluator) (Val, error) {
+						dir, err := e.cwd()
+						if err != nil {
+							return nil, fsError(err, ".")
+						}
+						return &LitStr{Value: dir}, nil
+					}), nil
+				},
+			},
+		},
+		"with_cwd": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					strType,
+					taskType(&TypeVar{Name: "a"}, fsErrorType("e")),
+					taskType(&TypeVar{Name: "a"}, fsErrorType("e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "with_cwd",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					dir, ok := args[0].(*LitStr)
+					if !ok {
+						return nil, errors.Errorf("invalid directory type %t", args[0])
+					}
+					task := args[1]
+
+					return thunk("with_cwd", func(e *Evaluator) (Val, error) {
+						path, err := filepath.Abs(e.path(dir.Value))
+						if err != nil {
+							return nil, fsError(err, dir.Value)
+						}
+						info, err := os.Stat(path)
+						if err != nil {
+							return nil, fsError(err, path)
+						}
+						if !info.IsDir() {
+							return nil, &TaskError{Val: &ConsVal{Name: "IoError", Payload: &LitStr{Value: path + " is not a directory"}}}
+						}
+
+						inDir := *e
+						inDir.dir = path
+						return inDir.evalFn(task, nil)
+					}), nil
+				},
+			},
+		},
+	}
+}
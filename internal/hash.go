@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+func bytesResult(b []byte, err error) (Val, error) {
+	if err != nil {
+		return errResult(err), nil
+	}
+	return okResult(&BytesVal{Value: b}), nil
+}
+
+func bytesArg(arg Val) (*BytesVal, error) {
+	b, ok := arg.(*BytesVal)
+	if !ok {
+		return nil, errors.Errorf("invalid bytes type %t", arg)
+	}
+	return b, nil
+}
+
+func digestItem(name string, newHash func() hash.Hash) Item {
+	return Item{
+		Type: &Scheme{Forall: nil, Type: lamType(bytesType, bytesType)},
+		Val: pureBuiltin(name, 1, func(args []Val) (Val, error) {
+			data, err := bytesArg(args[0])
+			if err != nil {
+				return nil, err
+			}
+			h := newHash()
+			h.Write(data.Value)
+			return &BytesVal{Value: h.Sum(nil)}, nil
+		}),
+	}
+}
+
+func hmacItem(name string, newHash func() hash.Hash) Item {
+	return Item{
+		Type: &Scheme{Forall: nil, Type: lamType(bytesType, bytesType, bytesType)},
+		Val: pureBuiltin(name, 2, func(args []Val) (Val, error) {
+			key, err := bytesArg(args[0])
+			if err != nil {
+				return nil, err
+			}
+			data, err := bytesArg(args[1])
+			if err != nil {
+				return nil, err
+			}
+			mac := hmac.New(newHash, key.Value)
+			mac.Write(data.Value)
+			return &BytesVal{Value: mac.Sum(nil)}, nil
+		}),
+	}
+}
+
+func encodeItem(name string, encode func([]byte) string) Item {
+	return Item{
+		Type: &Scheme{Forall: nil, Type: lamType(bytesType, strType)},
+		Val: pureBuiltin(name, 1, func(args []Val) (Val, error) {
+			data, err := bytesArg(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return &LitStr{Value: encode(data.Value)}, nil
+		}),
+	}
+}
+
+func decodeItem(name string, decode func(string) ([]byte, error)) Item {
+	return Item{
+		Type: &Scheme{Forall: nil, Type: lamType(strType, resultType(bytesType))},
+		Val: pureBuiltin(name, 1, func(args []Val) (Val, error) {
+			text, err := strArg(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return bytesResult(decode(text.Value))
+		}),
+	}
+}
+
+func hashItems() map[string]Item {
+	return map[string]Item{
+		"to_bytes": {
+			Type: &Scheme{Forall: nil, Type: lamType(strType, bytesType)},
+			Val: pureBuiltin("to_bytes", 1, func(args []Val) (Val, error) {
+				text, err := strArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return &BytesVal{Value: []byte(text.Value)}, nil
+			}),
+		},
+		"from_bytes": {
+			Type: &Scheme{Forall: nil, Type: lamType(bytesType, resultType(strType))},
+			Val: pureBuiltin("from_bytes", 1, func(args []Val) (Val, error) {
+				data, err := bytesArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				b := data.Value
+				if !utf8.Valid(b) {
+					return &ConsVal{Name: "Err", Payload: &LitStr{Value: "invalid UTF-8"}}, nil
+				}
+				return okResult(&LitStr{Value: string(b)}), nil
+			}),
+		},
+		"sha256":        digestItem("sha256", sha256.New),
+		"sha1":          digestItem("sha1", sha1.New),
+		"md5":           digestItem("md5", md5.New),
+		"hmac_sha256":   hmacItem("hmac_sha256", sha256.New),
+		"hmac_sha1":     hmacItem("hmac_sha1", sha1.New),
+		"hex_encode":    encodeItem("hex_encode", hex.EncodeToString),
+		"hex_decode":    decodeItem("hex_decode", hex.DecodeString),
+		"base64_encode": encodeItem("base64_encode", base64.StdEncoding.EncodeToString),
+		"base64_decode": decodeItem("base64_decode", base64.StdEncoding.DecodeString),
+		// URL-safe base64 is written without padding, as in URLs and JWTs,
+		// and read with or without it
+		"base64url_encode": encodeItem("base64url_encode", base64.RawURLEncoding.EncodeToString),
+		"base64url_decode": decodeItem("base64url_decode", func(s string) ([]byte, error) {
+			return base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+		}),
+	}
+}
@@ -0,0 +1,20 @@
+package internal
+
+import "testing"
+
+func TestHashBuiltinsRejectWrongArguments(t *testing.T) {
+	items := hashItems()
+	for name, args := range map[string][]Val{
+		"sha256":      {&LitStr{Value: "a"}},
+		"hmac_sha256": {&BytesVal{}, &LitStr{Value: "a"}},
+		"hex_encode":  {&Int{Value: 1}},
+		"hex_decode":  {&BytesVal{}},
+		"to_bytes":    {&BytesVal{}},
+		"from_bytes":  {&LitStr{Value: "a"}},
+	} {
+		builtin := items[name].Val.(*Builtin)
+		if _, err := builtin.Impl(nil, args); err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+}
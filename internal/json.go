@@ -0,0 +1,403 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/samber/lo"
+)
+
+const jsonConsName = "Json"
+
+// jsonType is the type of parsed JSON documents. Types cannot refer to
+// themselves, so it is opaque; json_case and json_of convert between it and
+// jsonCaseType, which describes one level of a document.
+var jsonType = &TypeCons{
+	Name: jsonConsName,
+	Args: nil,
+}
+
+var jsonCaseType = &TypeRec{
+	Entries: map[string]Type{
+		"JObject": listType(tupleType(strType, jsonType)),
+		"JArray":  listType(jsonType),
+		"JString": strType,
+		"JNumber": floatType,
+		"JBool":   boolType,
+		"JNull":   unitType,
+	},
+	RestVar: nil,
+	Union:   true,
+}
+
+var jsonErrorType = &TypeRec{
+	Entries: map[string]Type{
+		"message": strType,
+		"offset":  intType,
+	},
+	RestVar: nil,
+	Union:   false,
+}
+
+// JsonVal is a JSON document, held as a value of jsonCaseType whose nested
+// documents are JsonVals too. It is shown as JSON text.
+type JsonVal struct {
+	Value *ConsVal
+	// Number is the text of a parsed JNumber, which its Float may not hold
+	// exactly. It is written back and decoded to Int as it is.
+	Number json.Number
+}
+
+func (j *JsonVal) val() {}
+
+func (j *JsonVal) Pretty(indent int) string {
+	var buf bytes.Buffer
+	if err := encodeJSON(&buf, j); err != nil {
+		return dent(indent, "<json>")
+	}
+	return dent(indent, buf.String())
+}
+
+func jsonArg(arg Val) (*JsonVal, error) {
+	doc, ok := arg.(*JsonVal)
+	if !ok {
+		return nil, errors.Errorf("invalid json type %t", arg)
+	}
+	return doc, nil
+}
+
+func isBool(cons *ConsVal) bool {
+	if cons.Name != "True" && cons.Name != "False" {
+		return false
+	}
+	rec, ok := cons.Payload.(*RecVal)
+	return cons.Payload == nil || ok && len(rec.Entries) == 0
+}
+
+func encodeString(buf *bytes.Buffer, s string) {
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	enc.Encode(s)
+	buf.Truncate(buf.Len() - 1) // Encode ends with a newline
+}
+
+// encodeJSON writes val to buf as JSON. Records become objects with their
+// keys sorted, lists and tuples arrays, Bool values booleans and other
+// constructors objects with a "tag" and a "value". JSON documents are
+// written as they are.
+func encodeJSON(buf *bytes.Buffer, val Val) error {
+	switch val := val.(type) {
+	case *Int:
+		buf.WriteString(fmt.Sprint(val.Value))
+	case *FloatVal:
+		if math.IsNaN(val.Value) || math.IsInf(val.Value, 0) {
+			return errors.Errorf("cannot encode %s as JSON", val.Pretty(0))
+		}
+		buf.WriteString(val.Pretty(0))
+	case *LitStr:
+		encodeString(buf, val.Value)
+	case *BytesVal:
+		encodeString(buf, base64.StdEncoding.EncodeToString(val.Value))
+	case *InstantVal:
+		encodeString(buf, val.Value.Format(time.RFC3339Nano))
+	case *DurationVal:
+		buf.WriteString(fmt.Sprint(val.Value.Milliseconds()))
+	case *ListVal:
+		return encodeArray(buf, val.Items)
+	case *TupleVal:
+		return encodeArray(buf, val.Items)
+	case *RecVal:
+		keys := lo.Keys(val.Entries)
+		sort.Strings(keys)
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			encodeString(buf, key)
+			buf.WriteByte(':')
+			if err := encodeJSON(buf, val.Entries[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	case *ConsVal:
+		if isBool(val) {
+			buf.WriteString(lo.Ternary(val.Name == "True", "true", "false"))
+			return nil
+		}
+		buf.WriteString(`{"tag":`)
+		encodeString(buf, val.Name)
+		buf.WriteString(`,"value":`)
+		payload := val.Payload
+		if payload == nil {
+			payload = unitVal
+		}
+		if err := encodeJSON(buf, payload); err != nil {
+			return err
+		}
+		buf.WriteByte('}')
+	case *JsonVal:
+		if val.Number != "" {
+			buf.WriteString(val.Number.String())
+			return nil
+		}
+		return encodeDocument(buf, val.Value)
+	case *DictVal:
+		return encodeDict(buf, val)
+	default:
+		return errors.Errorf("cannot encode %s as JSON", val.Pretty(0))
+	}
+
+	return nil
+}
+
+func encodeArray(buf *bytes.Buffer, items []Val) error {
+	buf.WriteByte('[')
+	for i, item := range items {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := encodeJSON(buf, item); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+	return nil
+}
+
+func encodeDocument(buf *bytes.Buffer, doc *ConsVal) error {
+	switch doc.Name {
+	case "JObject":
+		buf.WriteByte('{')
+		for i, item := range doc.Payload.(*ListVal).Items {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			entry := item.(*TupleVal)
+			encodeString(buf, entry.Items[0].(*LitStr).Value)
+			buf.WriteByte(':')
+			if err := encodeJSON(buf, entry.Items[1]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	case "JBool":
+		return encodeJSON(buf, doc.Payload)
+	case "JNull":
+		buf.WriteString("null")
+		return nil
+	default:
+		return encodeJSON(buf, doc.Payload)
+	}
+}
+
+// encodeDict writes a dictionary with Str keys as an object, in the order of
+// its keys, and any other as an array of key and value pairs.
+func encodeDict(buf *bytes.Buffer, dict *DictVal) error {
+	if len(dict.keys) > 0 {
+		if _, ok := dict.keys[0].(*LitStr); !ok {
+			var pairs []Val
+			for _, key := range dict.keys {
+				val, _ := dict.get(key)
+				pairs = append(pairs, &TupleVal{Items: []Val{key, val}})
+			}
+			return encodeArray(buf, pairs)
+		}
+	}
+
+	buf.WriteByte('{')
+	for i, key := range dict.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		encodeString(buf, key.(*LitStr).Value)
+		buf.WriteByte(':')
+		val, _ := dict.get(key)
+		if err := encodeJSON(buf, val); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// parseJSON parses a JSON document, keeping the order of object keys.
+func parseJSON(data []byte) (*JsonVal, error) {
+	// Unmarshal reports malformed input with its offset; decoding the
+	// tokens of a valid document then cannot fail.
+	var raw json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	return decodeDocument(dec)
+}
+
+func decodeDocument(dec *json.Decoder) (*JsonVal, error) {
+	token, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	var doc *ConsVal
+	switch token := token.(type) {
+	case json.Delim:
+		if token == '[' {
+			var items []Val
+			for dec.More() {
+				item, err := decodeDocument(dec)
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			doc = &ConsVal{Name: "JArray", Payload: &ListVal{Items: items}}
+		} else {
+			var entries []Val
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				item, err := decodeDocument(dec)
+				if err != nil {
+					return nil, err
+				}
+				entries = append(entries, &TupleVal{Items: []Val{&LitStr{Value: key.(string)}, item}})
+			}
+			doc = &ConsVal{Name: "JObject", Payload: &ListVal{Items: entries}}
+		}
+
+		// the closing delimiter
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+	case string:
+		doc = &ConsVal{Name: "JString", Payload: &LitStr{Value: token}}
+	case json.Number:
+		f, err := token.Float64()
+		if err != nil {
+			return nil, err
+		}
+		doc = &ConsVal{Name: "JNumber", Payload: &FloatVal{Value: f}}
+		return &JsonVal{Value: doc, Number: token}, nil
+	case bool:
+		doc = &ConsVal{Name: "JBool", Payload: boolVal(token)}
+	case nil:
+		doc = &ConsVal{Name: "JNull", Payload: unitVal}
+	}
+
+	return &JsonVal{Value: doc}, nil
+}
+
+// jsonError is the failure of parse_json for err.
+func jsonError(err error) Val {
+	offset := 0
+	var syntaxErr *json.SyntaxError
+	if errors.As(err, &syntaxErr) {
+		offset = int(syntaxErr.Offset)
+	}
+	if errors.Is(err, io.ErrUnexpectedEOF) {
+		err = errors.New("unexpected end of JSON input")
+	}
+
+	return &ConsVal{Name: "Err", Payload: &RecVal{Entries: map[string]Val{
+		"message": &LitStr{Value: err.Error()},
+		"offset":  &Int{Value: offset},
+	}}}
+}
+
+// encodable is the constraint of values given to to_json: functions, tasks,
+// refs and channels have no JSON form.
+var encodable = &constraint{
+	message: "cannot encode value of type %s as JSON",
+	rejects: func(cons *TypeCons) bool {
+		switch cons.Name {
+		case intConsName, strConsName, floatConsName, bytesConsName, instantConsName, durationConsName,
+			listConsName, tupleConsName, dictConsName, jsonConsName:
+			return false
+		default:
+			return true
+		}
+	},
+}
+
+func jsonItems() map[string]Item {
+	return map[string]Item{
+		"to_json": {
+			Type: &Scheme{
+				Forall:      []string{"a"},
+				Type:        lamType(&TypeVar{Name: "a"}, strType),
+				constraints: map[string][]*constraint{"a": {encodable}},
+			},
+			Val: pureBuiltin("to_json", 1, func(args []Val) (Val, error) {
+				var buf bytes.Buffer
+				if err := encodeJSON(&buf, args[0]); err != nil {
+					return nil, err
+				}
+				return &LitStr{Value: buf.String()}, nil
+			}),
+		},
+		"parse_json": {
+			Type: &Scheme{
+				Forall: nil,
+				Type: lamType(strType, &TypeRec{
+					Entries: map[string]Type{"Ok": jsonType, "Err": jsonErrorType},
+					RestVar: nil,
+					Union:   true,
+				}),
+			},
+			Val: pureBuiltin("parse_json", 1, func(args []Val) (Val, error) {
+				text, err := strArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				doc, err := parseJSON([]byte(text.Value))
+				if err != nil {
+					return jsonError(err), nil
+				}
+				return okResult(doc), nil
+			}),
+		},
+		decodeName: {
+			Type: decodeScheme,
+			Val: &Builtin{
+				Name: decodeName,
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					return nil, errors.New("decode must be used at a known type")
+				},
+			},
+		},
+		"json_case": {
+			Type: &Scheme{Forall: nil, Type: lamType(jsonType, jsonCaseType)},
+			Val: pureBuiltin("json_case", 1, func(args []Val) (Val, error) {
+				doc, err := jsonArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return doc.Value, nil
+			}),
+		},
+		"json_of": {
+			Type: &Scheme{Forall: nil, Type: lamType(jsonCaseType, jsonType)},
+			Val: pureBuiltin("json_of", 1, func(args []Val) (Val, error) {
+				cons, ok := args[0].(*ConsVal)
+				if !ok {
+					return nil, errors.Errorf("invalid json case type %t", args[0])
+				}
+				return &JsonVal{Value: cons}, nil
+			}),
+		},
+	}
+}
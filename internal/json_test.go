@@ -0,0 +1,22 @@
+package internal
+
+import "testing"
+
+func TestParseJSONKeepsLargeIntegers(t *testing.T) {
+	doc, err := parseJSON([]byte(`[9007199254740993, 1e3]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, want := doc.Pretty(0), `[9007199254740993,1e3]`; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+
+	val, err := decodeJSON(listType(intType), doc, "$")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := val.Pretty(0), "[9007199254740993, 1000]"; got != want {
+		t.Errorf("got %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,282 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// runPar runs tasks on their own goroutines and returns their results in
+// order. The first task to fail cancels the others, and its error is the
+// one returned.
+func runPar(e *Evaluator, tasks []Val) ([]Val, error) {
+	ctx, cancel := context.WithCancel(e.ctx)
+	defer cancel()
+	sibling := e.withContext(ctx)
+
+	results := make([]Val, len(tasks))
+	var firstErr error
+	var failed sync.Once
+	var wg sync.WaitGroup
+	for i, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := sibling.evalFn(task, nil)
+			if err != nil {
+				failed.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			results[i] = res
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return results, nil
+}
+
+// runRace runs tasks on their own goroutines and returns the outcome of the
+// first to finish, after cancelling and waiting for the others.
+func runRace(e *Evaluator, tasks []Val) (Val, error) {
+	ctx, cancel := context.WithCancel(e.ctx)
+	defer cancel()
+	sibling := e.withContext(ctx)
+
+	type outcome struct {
+		val Val
+		err error
+	}
+	outcomes := make(chan outcome, len(tasks))
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res, err := sibling.evalFn(task, nil)
+			outcomes <- outcome{val: res, err: err}
+		}()
+	}
+
+	first := <-outcomes
+	cancel()
+	wg.Wait()
+	return first.val, first.err
+}
+
+// runTimeout runs task and fails it with `Timeout ms` if it does not finish
+// within ms milliseconds. The task is cancelled then, and its cleanups run
+// before runTimeout returns.
+func runTimeout(e *Evaluator, ms int, task Val) (Val, error) {
+	ctx, cancel := context.WithTimeout(e.ctx, time.Duration(ms)*time.Millisecond)
+	defer cancel()
+
+	res, err := e.withContext(ctx).evalFn(task, nil)
+	if err != nil && e.ctx.Err() == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, &TaskError{Val: &ConsVal{Name: "Timeout", Payload: &Int{Value: ms}}}
+	}
+	return res, err
+}
+
+func parItems() map[string]Item {
+	return map[string]Item{
+		"par": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					listType(taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"})),
+					taskType(listType(&TypeVar{Name: "a"}), &TypeVar{Name: "e"}),
+				),
+			},
+			Val: &Builtin{
+				Name: "par",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					tasks, ok := args[0].(*ListVal)
+					if !ok {
+						return nil, errors.Errorf("invalid tasks type %t", args[0])
+					}
+
+					return thunk("par", func(e *Evaluator) (Val, error) {
+						results, err := runPar(e, tasks.Items)
+						if err != nil {
+							return nil, err
+						}
+						return &ListVal{Items: results}, nil
+					}), nil
+				},
+			},
+		},
+		"both": {
+			Type: &Scheme{
+				Forall: []string{"a", "b", "e"},
+				Type: lamType(
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+					taskType(&TypeVar{Name: "b"}, &TypeVar{Name: "e"}),
+					taskType(tupleType(&TypeVar{Name: "a"}, &TypeVar{Name: "b"}), &TypeVar{Name: "e"}),
+				),
+			},
+			Val: &Builtin{
+				Name: "both",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+
+					return thunk("both", func(e *Evaluator) (Val, error) {
+						results, err := runPar(e, args)
+						if err != nil {
+							return nil, err
+						}
+						return &TupleVal{Items: results}, nil
+					}), nil
+				},
+			},
+		},
+		"race": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+				),
+			},
+			Val: &Builtin{
+				Name: "race",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+
+					return thunk("race", func(e *Evaluator) (Val, error) {
+						return runRace(e, args)
+					}), nil
+				},
+			},
+		},
+		"par_map": {
+			Type: &Scheme{
+				Forall: []string{"a", "b", "e"},
+				Type: lamType(
+					listType(&TypeVar{Name: "a"}),
+					lamType(&TypeVar{Name: "a"}, taskType(&TypeVar{Name: "b"}, &TypeVar{Name: "e"})),
+					taskType(listType(&TypeVar{Name: "b"}), &TypeVar{Name: "e"}),
+				),
+			},
+			Val: &Builtin{
+				Name: "par_map",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					items, ok := args[0].(*ListVal)
+					if !ok {
+						return nil, errors.Errorf("invalid list type %t", args[0])
+					}
+					mapper := args[1]
+
+					return thunk("par_map", func(e *Evaluator) (Val, error) {
+						var tasks []Val
+						for _, item := range items.Items {
+							task, err := e.evalFn(mapper, []Val{item})
+							if err != nil {
+								return nil, err
+							}
+							tasks = append(tasks, task)
+						}
+
+						results, err := runPar(e, tasks)
+						if err != nil {
+							return nil, err
+						}
+						return &ListVal{Items: results}, nil
+					}), nil
+				},
+			},
+		},
+		"timeout": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					intType,
+					taskType(&TypeVar{Name: "a"}, unionType(map[string]Type{"Timeout": intType}, "e")),
+					taskType(&TypeVar{Name: "a"}, unionType(map[string]Type{"Timeout": intType}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "timeout",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					ms, ok := args[0].(*Int)
+					if !ok {
+						return nil, errors.Errorf("invalid timeout type %t", args[0])
+					}
+					task := args[1]
+
+					return thunk("timeout", func(e *Evaluator) (Val, error) {
+						return runTimeout(e, ms.Value, task)
+					}), nil
+				},
+			},
+		},
+		"bracket": {
+			Type: &Scheme{
+				Forall: []string{"r", "a", "b", "e"},
+				Type: lamType(
+					taskType(&TypeVar{Name: "r"}, &TypeVar{Name: "e"}),
+					lamType(&TypeVar{Name: "r"}, taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"})),
+					lamType(&TypeVar{Name: "r"}, taskType(&TypeVar{Name: "b"}, &TypeVar{Name: "e"})),
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+				),
+			},
+			Val: &Builtin{
+				Name: "bracket",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 3 {
+						return nil, errors.Errorf("expecting 3 arguments, got %d", len(args))
+					}
+					acquire, use, release := args[0], args[1], args[2]
+
+					return thunk("bracket", func(e *Evaluator) (Val, error) {
+						return e.bracket(acquire, use, release)
+					}), nil
+				},
+			},
+		},
+		"finally": {
+			Type: &Scheme{
+				Forall: []string{"a", "b", "e"},
+				Type: lamType(
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+					taskType(&TypeVar{Name: "b"}, &TypeVar{Name: "e"}),
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+				),
+			},
+			Val: &Builtin{
+				Name: "finally",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					task, cleanup := args[0], args[1]
+
+					return thunk("finally", func(e *Evaluator) (Val, error) {
+						return e.finally(task, cleanup)
+					}), nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,187 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// runOptionType is the type of the options `run` takes.
+var runOptionType = &TypeRec{
+	Entries: map[string]Type{
+		"Dir":     strType,
+		"Env":     tupleType(strType, strType),
+		"Stdin":   strType,
+		"Timeout": intType,
+	},
+	RestVar: nil,
+	Union:   true,
+}
+
+var runResultType = &TypeRec{
+	Entries: map[string]Type{
+		"stdout": strType,
+		"stderr": strType,
+		"code":   intType,
+	},
+	RestVar: nil,
+	Union:   false,
+}
+
+func runErrorType(rest string) *TypeRec {
+	return unionType(map[string]Type{
+		"NotFound":         strType,
+		"PermissionDenied": strType,
+		"Timeout":          intType,
+		"IoError":          strType,
+	}, rest)
+}
+
+// runCommand runs name with args as set up by options and waits for it. A
+// command that exits with a non-zero code has run successfully; only failing
+// to run it at all, or to finish in time, fails the task.
+func runCommand(e *Evaluator, name string, args []string, options []Val) (Val, error) {
+	dir := e.dir
+	var stdin string
+	var env []string
+	timeout := 0
+	for _, option := range options {
+		cons, ok := option.(*ConsVal)
+		if !ok {
+			return nil, errors.Errorf("invalid option type %t", option)
+		}
+
+		switch cons.Name {
+		case "Dir":
+			path, ok := cons.Payload.(*LitStr)
+			if !ok {
+				return nil, errors.Errorf("invalid Dir payload type %T", cons.Payload)
+			}
+			dir = e.path(path.Value)
+		case "Env":
+			pair, ok := cons.Payload.(*TupleVal)
+			if !ok || len(pair.Items) != 2 {
+				return nil, errors.Errorf("invalid Env payload %s", show(cons.Payload))
+			}
+			name, ok := pair.Items[0].(*LitStr)
+			if !ok {
+				return nil, errors.Errorf("invalid Env name type %T", pair.Items[0])
+			}
+			value, ok := pair.Items[1].(*LitStr)
+			if !ok {
+				return nil, errors.Errorf("invalid Env value type %T", pair.Items[1])
+			}
+			env = append(env, name.Value+"="+value.Value)
+		case "Stdin":
+			input, ok := cons.Payload.(*LitStr)
+			if !ok {
+				return nil, errors.Errorf("invalid Stdin payload type %T", cons.Payload)
+			}
+			stdin = input.Value
+		case "Timeout":
+			millis, ok := cons.Payload.(*Int)
+			if !ok {
+				return nil, errors.Errorf("invalid Timeout payload type %T", cons.Payload)
+			}
+			timeout = millis.Value
+		default:
+			return nil, errors.Errorf("unknown option %s", cons.Name)
+		}
+	}
+
+	ctx := e.ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(timeout)*time.Millisecond)
+		defer cancel()
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = dir
+	cmd.Stdin = strings.NewReader(stdin)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if env != nil {
+		cmd.Env = append(os.Environ(), env...)
+	}
+
+	err := cmd.Run()
+	var exitErr *exec.ExitError
+	switch {
+	case e.ctx.Err() != nil:
+		return nil, e.ctx.Err()
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return nil, &TaskError{Val: &ConsVal{Name: "Timeout", Payload: &Int{Value: timeout}}}
+	case err == nil || errors.As(err, &exitErr):
+		return &RecVal{Entries: map[string]Val{
+			"stdout": &LitStr{Value: stdout.String()},
+			"stderr": &LitStr{Value: stderr.String()},
+			"code":   &Int{Value: cmd.ProcessState.ExitCode()},
+		}}, nil
+	case errors.Is(err, exec.ErrNotFound), errors.Is(err, fs.ErrNotExist):
+		return nil, &TaskError{Val: &ConsVal{Name: "NotFound", Payload: &LitStr{Value: name}}}
+	case errors.Is(err, fs.ErrPermission):
+		return nil, &TaskError{Val: &ConsVal{Name: "PermissionDenied", Payload: &LitStr{Value: name}}}
+	default:
+		return nil, &TaskError{Val: &ConsVal{Name: "IoError", Payload: &LitStr{Value: err.Error()}}}
+	}
+}
+
+func processItems() map[string]Item {
+	return map[string]Item{
+		"run": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type: lamType(
+					strType,
+					listType(strType),
+					listType(runOptionType),
+					taskType(runResultType, runErrorType("e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "run",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 3 {
+						return nil, errors.Errorf("expecting 3 arguments, got %d", len(args))
+					}
+					name, ok := args[0].(*LitStr)
+					if !ok {
+						return nil, errors.Errorf("invalid command type %t", args[0])
+					}
+					cmdArgs, ok := args[1].(*ListVal)
+					if !ok {
+						return nil, errors.Errorf("invalid arguments type %t", args[1])
+					}
+					options, ok := args[2].(*ListVal)
+					if !ok {
+						return nil, errors.Errorf("invalid options type %t", args[2])
+					}
+
+					var strs []string
+					for _, arg := range cmdArgs.Items {
+						str, ok := arg.(*LitStr)
+						if !ok {
+							return nil, errors.Errorf("invalid argument type %T", arg)
+						}
+						strs = append(strs, str.Value)
+					}
+
+					return &Builtin{
+						Name: "run_thunk",
+						Impl: func(e *Evaluator, args []Val) (Val, error) {
+							return runCommand(e, name.Value, strs, options.Items)
+						},
+					}, nil
+				},
+			},
+		},
+	}
+}
@@ -1,9 +1,15 @@
 package internal
 
 import (
+	"bufio"
+	"context"
 	tree_sitter_fun "fun/tree-sitter-fun/bindings/go"
+	"io"
+	"math/rand/v2"
 	"os"
 	"path"
+	"regexp"
+	"sync"
 
 	"github.com/pkg/errors"
 	tree_sitter "github.com/tree-sitter/go-tree-sitter"
@@ -11,6 +17,39 @@ import (
 
 const InlineModule = "<root>"
 
+// Stage is a step of running a module, used to tell apart why Run failed.
+type Stage int
+
+const (
+	ParseStage Stage = iota + 1
+	InferStage
+	EvalStage
+)
+
+// StageError is returned by Run and records the stage that failed.
+type StageError struct {
+	Stage Stage
+	err   error
+}
+
+func (s *StageError) Error() string {
+	return s.err.Error()
+}
+
+func (s *StageError) Unwrap() error {
+	return s.err
+}
+
+// stageError wraps err as failing at stage, unless it comes from a module
+// imported at that stage: then the stage the import failed at is kept.
+func stageError(stage Stage, err error, format string, args ...any) *StageError {
+	var inner *StageError
+	if errors.As(err, &inner) {
+		stage = inner.Stage
+	}
+	return &StageError{Stage: stage, err: errors.WithMessagef(err, format, args...)}
+}
+
 type Module struct {
 	ImportPath string
 	Expr
@@ -25,6 +64,39 @@ type Program struct {
 	Modules     map[string]*Module
 	env         *Env
 	importStack []string
+
+	// runMu serializes Run, which owns the parser, the inferrer and the
+	// import stack. modulesMu guards Modules, which tasks running
+	// concurrently read when they evaluate imports.
+	runMu     sync.Mutex
+	modulesMu sync.Mutex
+	// runCtx is the context of the Run in progress, used to evaluate the
+	// modules it imports
+	runCtx context.Context
+
+	// Args are the command-line arguments passed to the program's entry
+	// point and returned by the `args` builtin.
+	Args []string
+
+	// Stdin, Stdout and Stderr are used by the standard input and output
+	// builtins. NewProgram sets them to the process's streams.
+	Stdin   io.Reader
+	Stdout  io.Writer
+	Stderr  io.Writer
+	reader  *bufio.Reader
+	stdinMu sync.Mutex
+
+	// Clock is used by the builtins that read the time or wait. NewProgram
+	// sets it to the system clock.
+	Clock Clock
+
+	// rand is the generator of the random builtins, see Seed
+	rand   *rand.Rand
+	randMu sync.Mutex
+
+	// regexps caches the patterns of the regex builtins, compiled
+	regexps   map[string]*regexp.Regexp
+	regexpsMu sync.Mutex
 }
 
 func NewProgram() (*Program, error) {
@@ -38,6 +110,10 @@ func NewProgram() (*Program, error) {
 		parser:      parser,
 		Modules:     map[string]*Module{},
 		importStack: nil,
+		Stdin:       os.Stdin,
+		Stdout:      os.Stdout,
+		Stderr:      os.Stderr,
+		Clock:       systemClock{},
 	}
 	p.evaluator = NewEvaluator(p)
 	p.inferer = NewInferrer(p)
@@ -45,17 +121,39 @@ func NewProgram() (*Program, error) {
 	return p, nil
 }
 
+// readStdin calls read with Stdin buffered, so that read_line and read_all
+// share what was read ahead. Reads are serialized, as tasks may run
+// concurrently.
+func (p *Program) readStdin(read func(r *bufio.Reader) (Val, error)) (Val, error) {
+	p.stdinMu.Lock()
+	defer p.stdinMu.Unlock()
+
+	if p.reader == nil {
+		p.reader = bufio.NewReader(p.Stdin)
+	}
+	return read(p.reader)
+}
+
+// Import returns the module at importPath, loading it on first use. Modules
+// are loaded while inferring the types of their importers, so a miss only
+// happens within Run; evaluating an import, possibly from concurrently
+// running tasks, only reads the cache.
 func (p *Program) Import(importPath string) (*Module, error) {
+	p.modulesMu.Lock()
 	mod, has := p.Modules[importPath]
+	p.modulesMu.Unlock()
 	if has {
 		return mod, nil
 	}
-	importedMod, err := p.importModule(importPath)
+
+	mod, err := p.importModule(importPath)
 	if err != nil {
 		return nil, err
 	}
-	mod = importedMod
+
+	p.modulesMu.Lock()
 	p.Modules[importPath] = mod
+	p.modulesMu.Unlock()
 	return mod, nil
 }
 
@@ -76,10 +174,25 @@ func (p *Program) importModule(importPath string) (*Module, error) {
 		return nil, errors.WithMessagef(err, "failed to read module `%s`", importPath)
 	}
 
-	return p.Run(source, importPath)
+	return p.run(source, importPath)
 }
 
-func (p *Program) Run(source []byte, importPath string) (*Module, error) {
+// Run parses, type checks and evaluates source as the module at importPath.
+// Cancelling ctx stops the evaluation. It is safe to call while tasks of
+// earlier modules are running.
+func (p *Program) Run(ctx context.Context, source []byte, importPath string) (*Module, error) {
+	p.runMu.Lock()
+	defer p.runMu.Unlock()
+
+	p.runCtx = ctx
+	defer func() {
+		p.runCtx = nil
+	}()
+
+	return p.run(source, importPath)
+}
+
+func (p *Program) run(source []byte, importPath string) (*Module, error) {
 	if importPath != InlineModule {
 		p.importStack = append(p.importStack, importPath)
 		defer func() {
@@ -93,20 +206,25 @@ func (p *Program) Run(source []byte, importPath string) (*Module, error) {
 	// parse
 	expr, err := fromNode(node, source)
 	if err != nil {
-		return nil, errors.WithMessagef(err, "failed to parse module: %s", importPath)
+		return nil, stageError(ParseStage, err, "failed to parse module: %s", importPath)
 	}
 
 	// type check
-	_, t, err := p.inferer.Infer(expr, p.env.Types())
+	typeArgs := len(p.inferer.typeArgs)
+	s, t, err := p.inferer.Infer(expr, p.env.Types())
+	if err == nil {
+		err = p.inferer.resolveTypeArgs(typeArgs, s)
+	}
 	if err != nil {
-		return nil, errors.WithMessagef(err, "failed to infer module type: %s", importPath)
+		p.inferer.typeArgs = p.inferer.typeArgs[:typeArgs]
+		return nil, stageError(InferStage, err, "failed to infer module type: %s", importPath)
 	}
 	scheme := generalize(t)
 
 	// evaluate
-	val, err := p.evaluator.Eval(expr, p.env.Values())
+	val, err := p.evaluator.withContext(p.runCtx).Eval(expr, p.env.Values())
 	if err != nil {
-		return nil, errors.WithMessagef(err, "failed to evaluate module: %s", importPath)
+		return nil, stageError(EvalStage, err, "failed to evaluate module: %s", importPath)
 	}
 
 	return &Module{
@@ -117,7 +235,12 @@ func (p *Program) Run(source []byte, importPath string) (*Module, error) {
 	}, nil
 }
 
-func (e *Evaluator) EvalTask(val Val, scheme *Scheme) (Val, *Scheme) {
+// EvalTask runs val while its type is a task. If a task fails, the error it
+// failed with is returned as a value of the task's error type, along with
+// the Go error itself: a *TaskError for failures raised by the program,
+// anything else for failures of the evaluator.
+func (e *Evaluator) EvalTask(ctx context.Context, val Val, scheme *Scheme) (Val, *Scheme, error) {
+	e = e.withContext(ctx)
 	for {
 		if cons, ok := scheme.Type.(*TypeCons); ok && cons.Name == taskConsName {
 			resultType := cons.Args[0]
@@ -125,17 +248,49 @@ func (e *Evaluator) EvalTask(val Val, scheme *Scheme) (Val, *Scheme) {
 
 			res, err := e.evalFn(val, nil)
 			if err != nil {
-				return errorVal(err), generalize(errType)
+				return errorVal(err), generalize(errType), err
 			}
 			val = res
 			scheme = generalize(resultType)
 			continue
 		}
 
-		return val, scheme
+		return val, scheme, nil
 	}
 }
 
-func (p *Program) EvalTask(m *Module) (Val, *Scheme) {
-	return p.evaluator.EvalTask(m.Val, m.Type)
+func (p *Program) EvalTask(ctx context.Context, m *Module) (Val, *Scheme, error) {
+	return p.evaluator.EvalTask(ctx, m.Val, m.Type)
+}
+
+// RunMain runs the entry point of a module. A module whose value is a
+// function from a List<Str> to a task, or a record with such a function in
+// its `main` field, is called with the program's Args. The result, or the module
+// value itself if it has no entry point, is then run with EvalTask.
+func (p *Program) RunMain(ctx context.Context, m *Module) (Val, *Scheme, error) {
+	val, typ := m.Val, m.Type.Type
+	if rec, ok := typ.(*TypeRec); ok && !rec.Union {
+		if mainType, has := rec.Entries["main"]; has {
+			val, typ = m.Val.(*RecVal).Entries["main"], mainType
+		}
+	}
+
+	lam, ok := typ.(*TypeCons)
+	if !ok || lam.Name != lambdaConsName || len(lam.Args) != 2 {
+		return p.evaluator.EvalTask(ctx, m.Val, m.Type)
+	}
+
+	subst, err := p.inferer.unify(lam.Args[0], listType(strType))
+	if err != nil {
+		return p.evaluator.EvalTask(ctx, m.Val, m.Type)
+	}
+	if task, ok := lam.Args[1].apply(subst).(*TypeCons); !ok || task.Name != taskConsName {
+		return p.evaluator.EvalTask(ctx, m.Val, m.Type)
+	}
+
+	res, err := p.evaluator.withContext(ctx).evalFn(val, []Val{strList(p.Args)})
+	if err != nil {
+		return errorVal(err), generalize(lam.Args[1].apply(subst)), err
+	}
+	return p.evaluator.EvalTask(ctx, res, generalize(lam.Args[1].apply(subst)))
 }
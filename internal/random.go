@@ -0,0 +1,214 @@
+package internal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/rand/v2"
+
+	"github.com/pkg/errors"
+)
+
+// Seed makes the random builtins of the program draw from a generator seeded
+// with seed, so that runs are reproducible.
+func (p *Program) Seed(seed uint64) {
+	p.randMu.Lock()
+	defer p.randMu.Unlock()
+
+	p.rand = rand.New(rand.NewPCG(seed, seed))
+}
+
+// random calls draw with the program's generator. Draws are serialized, as
+// tasks may run concurrently.
+func (p *Program) random(draw func(r *rand.Rand)) {
+	p.randMu.Lock()
+	defer p.randMu.Unlock()
+
+	if p.rand == nil {
+		p.rand = rand.New(rand.NewPCG(rand.Uint64(), rand.Uint64()))
+	}
+	draw(p.rand)
+}
+
+func formatUUID(b [16]byte) string {
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// newUUID returns a random UUID of the given version. Version 7 UUIDs start
+// with the Unix time in milliseconds, so that they sort by creation time.
+func newUUID(e *Evaluator, version byte) string {
+	var b [16]byte
+	e.program.random(func(r *rand.Rand) {
+		binary.BigEndian.PutUint64(b[0:8], r.Uint64())
+		binary.BigEndian.PutUint64(b[8:16], r.Uint64())
+	})
+
+	if version == 7 {
+		ms := uint64(e.program.Clock.Now().UnixMilli())
+		b[0], b[1], b[2] = byte(ms>>40), byte(ms>>32), byte(ms>>24)
+		b[3], b[4], b[5] = byte(ms>>16), byte(ms>>8), byte(ms)
+	}
+	b[6] = b[6]&0x0f | version<<4
+	b[8] = b[8]&0x3f | 0x80
+	return formatUUID(b)
+}
+
+// randomOffset returns a number from 0 to span, both included. The span of
+// a range of ints can be as wide as all of them, which no int holds.
+func randomOffset(r *rand.Rand, span uint64) uint64 {
+	if span == math.MaxUint64 {
+		return r.Uint64()
+	}
+	return r.Uint64N(span + 1)
+}
+
+func randomItems() map[string]Item {
+	return map[string]Item{
+		"random_int": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(intType, intType, taskType(intType, unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "random_int",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					lo, err := intArg(args[0])
+					if err != nil {
+						return nil, err
+					}
+					hi, err := intArg(args[1])
+					if err != nil {
+						return nil, err
+					}
+					if hi.Value < lo.Value {
+						return nil, errors.Errorf("empty range %d..%d", lo.Value, hi.Value)
+					}
+
+					return thunk("random_int", func(e *Evaluator) (Val, error) {
+						var n int
+						e.program.random(func(r *rand.Rand) {
+							n = lo.Value + int(randomOffset(r, uint64(hi.Value)-uint64(lo.Value)))
+						})
+						return &Int{Value: n}, nil
+					}), nil
+				},
+			},
+		},
+		"random_float": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(taskType(floatType, unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "random_float",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					return thunk("random_float", func(e *Evaluator) (Val, error) {
+						var f float64
+						e.program.random(func(r *rand.Rand) {
+							f = r.Float64()
+						})
+						return &FloatVal{Value: f}, nil
+					}), nil
+				},
+			},
+		},
+		"shuffle": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					listType(&TypeVar{Name: "a"}),
+					taskType(listType(&TypeVar{Name: "a"}), unionType(map[string]Type{}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "shuffle",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					list, ok := args[0].(*ListVal)
+					if !ok {
+						return nil, errors.Errorf("invalid list type %t", args[0])
+					}
+
+					return thunk("shuffle", func(e *Evaluator) (Val, error) {
+						items := append([]Val(nil), list.Items...)
+						e.program.random(func(r *rand.Rand) {
+							r.Shuffle(len(items), func(i, j int) {
+								items[i], items[j] = items[j], items[i]
+							})
+						})
+						return &ListVal{Items: items}, nil
+					}), nil
+				},
+			},
+		},
+		"choose": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					listType(&TypeVar{Name: "a"}),
+					taskType(&TypeRec{
+						Entries: map[string]Type{"Some": &TypeVar{Name: "a"}, "None": unitType},
+						RestVar: nil,
+						Union:   true,
+					}, unionType(map[string]Type{}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "choose",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					list, ok := args[0].(*ListVal)
+					if !ok {
+						return nil, errors.Errorf("invalid list type %t", args[0])
+					}
+
+					return thunk("choose", func(e *Evaluator) (Val, error) {
+						if len(list.Items) == 0 {
+							return &ConsVal{Name: "None", Payload: unitVal}, nil
+						}
+						var i int
+						e.program.random(func(r *rand.Rand) {
+							i = r.IntN(len(list.Items))
+						})
+						return &ConsVal{Name: "Some", Payload: list.Items[i]}, nil
+					}), nil
+				},
+			},
+		},
+		"uuid_v4": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(taskType(strType, unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "uuid_v4",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					return thunk("uuid_v4", func(e *Evaluator) (Val, error) {
+						return &LitStr{Value: newUUID(e, 4)}, nil
+					}), nil
+				},
+			},
+		},
+		"uuid_v7": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(taskType(strType, unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "uuid_v7",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					return thunk("uuid_v7", func(e *Evaluator) (Val, error) {
+						return &LitStr{Value: newUUID(e, 7)}, nil
+					}), nil
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestRandomIntFullRange(t *testing.T) {
+	program, err := NewProgram()
+	if err != nil {
+		t.Fatal(err)
+	}
+	program.Seed(1)
+
+	source := "random_int(0 - 9223372036854775807 - 1, 9223372036854775807)"
+	mod, err := program.Run(context.Background(), []byte(source), InlineModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val, _, err := program.EvalTask(context.Background(), mod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := val.(*Int); !ok {
+		t.Fatalf("got %s, want an int", val.Pretty(0))
+	}
+}
+
+func TestRandomIntRejectsWrongArguments(t *testing.T) {
+	builtin := randomItems()["random_int"].Val.(*Builtin)
+	if _, err := builtin.Impl(nil, []Val{&Int{Value: 5}, &Int{Value: 4}}); err == nil {
+		t.Error("empty range: got no error")
+	}
+	if _, err := builtin.Impl(nil, []Val{&Int{Value: math.MinInt}, &FloatVal{}}); err == nil {
+		t.Error("float bound: got no error")
+	}
+}
@@ -0,0 +1,162 @@
+package internal
+
+import (
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+)
+
+const refConsName = "Ref"
+
+func refType(item Type) *TypeCons {
+	return &TypeCons{
+		Name: refConsName,
+		Args: []Type{item},
+	}
+}
+
+// RefVal is a mutable cell. It is only created and used by tasks, so pure
+// code never observes it change.
+type RefVal struct {
+	cell atomic.Pointer[refCell]
+}
+
+// refCell boxes a value, as the value an atomic pointer swaps must have a
+// single type.
+type refCell struct {
+	val Val
+}
+
+func (r *RefVal) val() {}
+
+func (r *RefVal) Pretty(indent int) string {
+	return dent(indent, "<ref>")
+}
+
+func refArg(arg Val) (*RefVal, error) {
+	ref, ok := arg.(*RefVal)
+	if !ok {
+		return nil, errors.Errorf("invalid ref type %t", arg)
+	}
+	return ref, nil
+}
+
+// modify sets the value of r to f applied to it and returns the new value.
+// If another task changes r while f runs, f is applied again to the newer
+// value.
+func (r *RefVal) modify(e *Evaluator, f Val) (Val, error) {
+	for {
+		old := r.cell.Load()
+		val, err := e.evalFn(f, []Val{old.val})
+		if err != nil {
+			return nil, err
+		}
+
+		if r.cell.CompareAndSwap(old, &refCell{val: val}) {
+			return val, nil
+		}
+	}
+}
+
+func refItems() map[string]Item {
+	return map[string]Item{
+		"new_ref": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type:   lamType(&TypeVar{Name: "a"}, taskType(refType(&TypeVar{Name: "a"}), unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "new_ref",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					initial := args[0]
+
+					return thunk("new_ref", func(e *Evaluator) (Val, error) {
+						ref := &RefVal{}
+						ref.cell.Store(&refCell{val: initial})
+						return ref, nil
+					}), nil
+				},
+			},
+		},
+		"read_ref": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type:   lamType(refType(&TypeVar{Name: "a"}), taskType(&TypeVar{Name: "a"}, unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "read_ref",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					ref, err := refArg(args[0])
+					if err != nil {
+						return nil, err
+					}
+
+					return thunk("read_ref", func(e *Evaluator) (Val, error) {
+						return ref.cell.Load().val, nil
+					}), nil
+				},
+			},
+		},
+		"write_ref": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					refType(&TypeVar{Name: "a"}),
+					&TypeVar{Name: "a"},
+					taskType(unitType, unionType(map[string]Type{}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "write_ref",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					ref, err := refArg(args[0])
+					if err != nil {
+						return nil, err
+					}
+					val := args[1]
+
+					return thunk("write_ref", func(e *Evaluator) (Val, error) {
+						ref.cell.Store(&refCell{val: val})
+						return unitVal, nil
+					}), nil
+				},
+			},
+		},
+		"modify_ref": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					refType(&TypeVar{Name: "a"}),
+					lamType(&TypeVar{Name: "a"}, &TypeVar{Name: "a"}),
+					taskType(&TypeVar{Name: "a"}, unionType(map[string]Type{}, "e")),
+				),
+			},
+			Val: &Builtin{
+				Name: "modify_ref",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					ref, err := refArg(args[0])
+					if err != nil {
+						return nil, err
+					}
+					f := args[1]
+
+					return thunk("modify_ref", func(e *Evaluator) (Val, error) {
+						return ref.modify(e, f)
+					}), nil
+				},
+			},
+		},
+	}
+}
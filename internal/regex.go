@@ -0,0 +1,160 @@
+package internal
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// regexMatchType is the type of a match: the text matched, the text of each
+// capture group in order and of the named ones by name. Groups that did not
+// take part in the match are empty.
+var regexMatchType = &TypeRec{
+	Entries: map[string]Type{
+		"match":  strType,
+		"groups": listType(strType),
+		"named":  dictType(strType, strType),
+	},
+	RestVar: nil,
+	Union:   false,
+}
+
+// maxRegexps is the number of compiled patterns a program keeps.
+const maxRegexps = 256
+
+// regexp returns pattern compiled. Patterns are compiled once per program,
+// as they are mostly literals used over and over. Patterns built at runtime
+// could grow the cache without end, so past maxRegexps one is dropped.
+func (p *Program) regexp(pattern string) (*regexp.Regexp, error) {
+	p.regexpsMu.Lock()
+	defer p.regexpsMu.Unlock()
+
+	if re, has := p.regexps[pattern]; has {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "invalid regex `%s`", pattern)
+	}
+	if p.regexps == nil {
+		p.regexps = map[string]*regexp.Regexp{}
+	}
+	if len(p.regexps) >= maxRegexps {
+		for cached := range p.regexps {
+			delete(p.regexps, cached)
+			break
+		}
+	}
+	p.regexps[pattern] = re
+	return re, nil
+}
+
+// matchVal is the match of re in text at loc, the submatch indexes as
+// returned by FindAllStringSubmatchIndex.
+func matchVal(re *regexp.Regexp, text string, loc []int) Val {
+	var groups []string
+	named := newDict()
+	for i := 1; i < len(loc)/2; i++ {
+		group := ""
+		if loc[2*i] >= 0 {
+			group = text[loc[2*i]:loc[2*i+1]]
+		}
+		groups = append(groups, group)
+		if name := re.SubexpNames()[i]; name != "" {
+			named.set(&LitStr{Value: name}, &LitStr{Value: group})
+		}
+	}
+
+	return &RecVal{Entries: map[string]Val{
+		"match":  &LitStr{Value: text[loc[0]:loc[1]]},
+		"groups": strList(groups),
+		"named":  named,
+	}}
+}
+
+// regexBuiltin makes a builtin whose first argument is a pattern and second
+// the text to search, passing them to impl with the pattern compiled. The
+// builtin returns the result of impl as Ok, or Err if the pattern is invalid.
+func regexBuiltin(name string, arity int, impl func(e *Evaluator, re *regexp.Regexp, text string, args []Val) (Val, error)) *Builtin {
+	return &Builtin{
+		Name: name,
+		Impl: func(e *Evaluator, args []Val) (Val, error) {
+			if len(args) != arity {
+				return nil, errors.Errorf("expecting %d arguments, got %d", arity, len(args))
+			}
+
+			pattern, err := strArg(args[0])
+			if err != nil {
+				return nil, err
+			}
+			text, err := strArg(args[1])
+			if err != nil {
+				return nil, err
+			}
+
+			re, err := e.program.regexp(pattern.Value)
+			if err != nil {
+				return errResult(err), nil
+			}
+			res, err := impl(e, re, text.Value, args[2:])
+			if err != nil {
+				return nil, err
+			}
+			return okResult(res), nil
+		},
+	}
+}
+
+func regexItems() map[string]Item {
+	return map[string]Item{
+		"regex_match": {
+			Type: &Scheme{Forall: nil, Type: lamType(strType, strType, resultType(boolType))},
+			Val: regexBuiltin("regex_match", 2, func(e *Evaluator, re *regexp.Regexp, text string, args []Val) (Val, error) {
+				return boolVal(re.MatchString(text)), nil
+			}),
+		},
+		"regex_find_all": {
+			Type: &Scheme{Forall: nil, Type: lamType(strType, strType, resultType(listType(regexMatchType)))},
+			Val: regexBuiltin("regex_find_all", 2, func(e *Evaluator, re *regexp.Regexp, text string, args []Val) (Val, error) {
+				var matches []Val
+				for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+					matches = append(matches, matchVal(re, text, loc))
+				}
+				return &ListVal{Items: matches}, nil
+			}),
+		},
+		"regex_replace": {
+			Type: &Scheme{
+				Forall: nil,
+				Type:   lamType(strType, strType, lamType(regexMatchType, strType), resultType(strType)),
+			},
+			Val: regexBuiltin("regex_replace", 3, func(e *Evaluator, re *regexp.Regexp, text string, args []Val) (Val, error) {
+				var sb strings.Builder
+				last := 0
+				for _, loc := range re.FindAllStringSubmatchIndex(text, -1) {
+					val, err := e.evalFn(args[0], []Val{matchVal(re, text, loc)})
+					if err != nil {
+						return nil, err
+					}
+					replacement, err := strArg(val)
+					if err != nil {
+						return nil, err
+					}
+					sb.WriteString(text[last:loc[0]])
+					sb.WriteString(replacement.Value)
+					last = loc[1]
+				}
+				sb.WriteString(text[last:])
+				return &LitStr{Value: sb.String()}, nil
+			}),
+		},
+		"regex_split": {
+			Type: &Scheme{Forall: nil, Type: lamType(strType, strType, resultType(listType(strType)))},
+			Val: regexBuiltin("regex_split", 2, func(e *Evaluator, re *regexp.Regexp, text string, args []Val) (Val, error) {
+				return strList(re.Split(text, -1)), nil
+			}),
+		},
+	}
+}
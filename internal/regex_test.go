@@ -0,0 +1,19 @@
+package internal
+
+import "testing"
+
+func TestRegexBuiltinsRejectWrongArguments(t *testing.T) {
+	program, err := NewProgram()
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewEvaluator(program)
+
+	builtin := regexItems()["regex_match"].Val.(*Builtin)
+	if _, err := builtin.Impl(e, []Val{&LitStr{Value: "a"}, &Int{Value: 1}}); err == nil {
+		t.Error("int text: got no error")
+	}
+	if _, err := builtin.Impl(e, []Val{&Int{Value: 1}, &LitStr{Value: "a"}}); err == nil {
+		t.Error("int pattern: got no error")
+	}
+}
@@ -0,0 +1,164 @@
+package internal
+
+import (
+	"math/rand/v2"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var retryPolicyType = &TypeRec{
+	Entries: map[string]Type{
+		"attempts":         intType,
+		"initial_delay_ms": intType,
+		"max_delay_ms":     intType,
+		"jitter":           boolType,
+	},
+	RestVar: nil,
+	Union:   false,
+}
+
+type retryPolicy struct {
+	attempts     int
+	initialDelay time.Duration
+	maxDelay     time.Duration
+	jitter       bool
+}
+
+func retryPolicyFromVal(val Val) (retryPolicy, error) {
+	rec, ok := val.(*RecVal)
+	if !ok {
+		return retryPolicy{}, errors.Errorf("invalid retry policy type %T", val)
+	}
+
+	attempts, err := policyInt(rec, "attempts")
+	if err != nil {
+		return retryPolicy{}, err
+	}
+	initialDelay, err := policyInt(rec, "initial_delay_ms")
+	if err != nil {
+		return retryPolicy{}, err
+	}
+	maxDelay, err := policyInt(rec, "max_delay_ms")
+	if err != nil {
+		return retryPolicy{}, err
+	}
+	jitter, ok := rec.Entries["jitter"].(*ConsVal)
+	if !ok {
+		return retryPolicy{}, errors.Errorf("invalid retry policy field jitter: %T", rec.Entries["jitter"])
+	}
+
+	return retryPolicy{
+		attempts:     attempts,
+		initialDelay: time.Duration(initialDelay) * time.Millisecond,
+		maxDelay:     time.Duration(maxDelay) * time.Millisecond,
+		jitter:       jitter.Name == "True",
+	}, nil
+}
+
+// policyInt returns the Int field name of a retry policy.
+func policyInt(rec *RecVal, name string) (int, error) {
+	field, ok := rec.Entries[name].(*Int)
+	if !ok {
+		return 0, errors.Errorf("invalid retry policy field %s: %T", name, rec.Entries[name])
+	}
+	return field.Value, nil
+}
+
+// retry runs task up to policy.attempts times while it fails with an error
+// that when accepts, sleeping on the program's clock in between. The delay
+// starts at initialDelay and doubles up to maxDelay; with jitter, a random
+// part of it is slept. Errors that are not task failures, like
+// cancellation, are never retried.
+func (e *Evaluator) retry(policy retryPolicy, when Val, task Val) (Val, error) {
+	delay := policy.initialDelay
+	for attempt := 1; ; attempt++ {
+		res, err := e.evalFn(task, nil)
+		var taskErr *TaskError
+		if err == nil || attempt >= policy.attempts || !errors.As(err, &taskErr) {
+			return res, err
+		}
+
+		if when != nil {
+			retry, predErr := e.evalFn(when, []Val{taskErr.Val})
+			if predErr != nil {
+				return nil, predErr
+			}
+			if b, ok := retry.(*ConsVal); !ok || b.Name != "True" {
+				return nil, err
+			}
+		}
+
+		wait := delay
+		if policy.jitter && delay > 0 {
+			e.program.random(func(r *rand.Rand) {
+				wait = time.Duration(r.Int64N(int64(delay) + 1))
+			})
+		}
+		if err := e.program.Clock.Sleep(e.ctx, wait); err != nil {
+			return nil, err
+		}
+		delay = min(delay*2, policy.maxDelay)
+	}
+}
+
+func retryItems() map[string]Item {
+	return map[string]Item{
+		"retry": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					retryPolicyType,
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+				),
+			},
+			Val: &Builtin{
+				Name: "retry",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 2 {
+						return nil, errors.Errorf("expecting 2 arguments, got %d", len(args))
+					}
+					policy, err := retryPolicyFromVal(args[0])
+					if err != nil {
+						return nil, err
+					}
+					task := args[1]
+
+					return thunk("retry", func(e *Evaluator) (Val, error) {
+						return e.retry(policy, nil, task)
+					}), nil
+				},
+			},
+		},
+		"retry_when": {
+			Type: &Scheme{
+				Forall: []string{"a", "e"},
+				Type: lamType(
+					lamType(&TypeVar{Name: "e"}, boolType),
+					retryPolicyType,
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+					taskType(&TypeVar{Name: "a"}, &TypeVar{Name: "e"}),
+				),
+			},
+			Val: &Builtin{
+				Name: "retry_when",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 3 {
+						return nil, errors.Errorf("expecting 3 arguments, got %d", len(args))
+					}
+					when := args[0]
+					policy, err := retryPolicyFromVal(args[1])
+					if err != nil {
+						return nil, err
+					}
+					task := args[2]
+
+					return thunk("retry_when", func(e *Evaluator) (Val, error) {
+						return e.retry(policy, when, task)
+					}), nil
+				},
+			},
+		},
+	}
+}
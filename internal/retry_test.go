@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+)
+
+// fakeClock records the waits instead of waiting.
+type fakeClock struct {
+	now    time.Time
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	c.now = c.now.Add(d)
+	return ctx.Err()
+}
+
+// runRetry runs a module whose task retries with the given policy and
+// returns how many times the retried task ran, and the waits in between. The
+// task fails with `Fatal` on its third run, with `Transient` otherwise.
+func runRetry(t *testing.T, retryCall string, jitter bool) (int, []time.Duration) {
+	t.Helper()
+
+	program, err := NewProgram()
+	if err != nil {
+		t.Fatal(err)
+	}
+	clock := &fakeClock{}
+	program.Clock = clock
+	program.Seed(1)
+
+	source := fmt.Sprintf(`policy = {attempts: 5, initial_delay_ms: 100, max_delay_ms: 300, jitter: %s {}}
+count <- new_ref(0)
+flaky = flat_map(modify_ref(count, \n -> n + 1), \n -> when n == 3 is True t -> fail(Fatal n); False f -> fail(Transient n))
+res <- catch(%s, \e -> ok(0))
+read_ref(count)
+`, map[bool]string{true: "True", false: "False"}[jitter], retryCall)
+
+	mod, err := program.Run(context.Background(), []byte(source), InlineModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	val, _, err := program.EvalTask(context.Background(), mod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return val.(*Int).Value, clock.sleeps
+}
+
+func TestRetryBackoff(t *testing.T) {
+	runs, sleeps := runRetry(t, `retry(policy, flaky)`, false)
+
+	if runs != 5 {
+		t.Errorf("got %d runs, want 5", runs)
+	}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond}
+	if !slices.Equal(sleeps, want) {
+		t.Errorf("got sleeps %v, want %v", sleeps, want)
+	}
+}
+
+func TestRetryJitter(t *testing.T) {
+	runs, sleeps := runRetry(t, `retry(policy, flaky)`, true)
+
+	if runs != 5 {
+		t.Errorf("got %d runs, want 5", runs)
+	}
+	limits := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 300 * time.Millisecond, 300 * time.Millisecond}
+	if len(sleeps) != len(limits) {
+		t.Fatalf("got sleeps %v, want %d of them", sleeps, len(limits))
+	}
+	if slices.Equal(sleeps, limits) {
+		t.Errorf("got sleeps %v, want them jittered", sleeps)
+	}
+	for i, sleep := range sleeps {
+		if sleep < 0 || sleep > limits[i] {
+			t.Errorf("sleep %d is %v, want at most %v", i, sleep, limits[i])
+		}
+	}
+
+	_, again := runRetry(t, `retry(policy, flaky)`, true)
+	if !slices.Equal(sleeps, again) {
+		t.Errorf("got sleeps %v and %v with the same seed", sleeps, again)
+	}
+}
+
+func TestRetryWhen(t *testing.T) {
+	runs, sleeps := runRetry(t, `retry_when(\e -> when e is Transient n -> True {}; Fatal n -> False {}, policy, flaky)`, false)
+
+	if runs != 3 {
+		t.Errorf("got %d runs, want 3", runs)
+	}
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond}
+	if !slices.Equal(sleeps, want) {
+		t.Errorf("got sleeps %v, want %v", sleeps, want)
+	}
+}
+
+func TestRetryPolicyFromValMalformed(t *testing.T) {
+	policy := &RecVal{Entries: map[string]Val{
+		"attempts":         &Int{Value: 3},
+		"initial_delay_ms": &LitStr{Value: "100"},
+		"max_delay_ms":     &Int{Value: 300},
+		"jitter":           falseVal,
+	}}
+	if _, err := retryPolicyFromVal(policy); err == nil {
+		t.Error("expected an error for a malformed policy")
+	}
+	if _, err := retryPolicyFromVal(&Int{Value: 1}); err == nil {
+		t.Error("expected an error for a policy that is not a record")
+	}
+}
@@ -0,0 +1,337 @@
+package internal
+
+import (
+	"time"
+	_ "time/tzdata"
+
+	"github.com/pkg/errors"
+)
+
+const instantConsName = "Instant"
+const durationConsName = "Duration"
+
+var instantType = &TypeCons{
+	Name: instantConsName,
+	Args: nil,
+}
+
+var durationType = &TypeCons{
+	Name: durationConsName,
+	Args: nil,
+}
+
+// resultType is the closed union pure functions that can fail return.
+func resultType(ok Type) *TypeRec {
+	return &TypeRec{
+		Entries: map[string]Type{"Ok": ok, "Err": strType},
+		RestVar: nil,
+		Union:   true,
+	}
+}
+
+func okResult(val Val) Val {
+	return &ConsVal{Name: "Ok", Payload: val}
+}
+
+func errResult(err error) Val {
+	return &ConsVal{Name: "Err", Payload: &LitStr{Value: err.Error()}}
+}
+
+// InstantVal is a point in time, in a time zone.
+type InstantVal struct {
+	Value time.Time
+}
+
+func (i *InstantVal) val() {}
+
+func (i *InstantVal) Pretty(indent int) string {
+	return dent(indent, i.Value.Format(time.RFC3339Nano))
+}
+
+// DurationVal is an amount of time.
+type DurationVal struct {
+	Value time.Duration
+}
+
+func (d *DurationVal) val() {}
+
+func (d *DurationVal) Pretty(indent int) string {
+	return dent(indent, d.Value.String())
+}
+
+// pureBuiltin makes a builtin of a pure function, checking the number of
+// arguments it is given.
+func pureBuiltin(name string, arity int, impl func(args []Val) (Val, error)) *Builtin {
+	return &Builtin{
+		Name: name,
+		Impl: func(e *Evaluator, args []Val) (Val, error) {
+			if len(args) != arity {
+				return nil, errors.Errorf("expecting %d arguments, got %d", arity, len(args))
+			}
+			return impl(args)
+		},
+	}
+}
+
+func intArg(arg Val) (*Int, error) {
+	i, ok := arg.(*Int)
+	if !ok {
+		return nil, errors.Errorf("invalid int type %t", arg)
+	}
+	return i, nil
+}
+
+func strArg(arg Val) (*LitStr, error) {
+	str, ok := arg.(*LitStr)
+	if !ok {
+		return nil, errors.Errorf("invalid string type %t", arg)
+	}
+	return str, nil
+}
+
+func listArg(arg Val) (*ListVal, error) {
+	list, ok := arg.(*ListVal)
+	if !ok {
+		return nil, errors.Errorf("invalid list type %t", arg)
+	}
+	return list, nil
+}
+
+func instantArg(arg Val) (*InstantVal, error) {
+	i, ok := arg.(*InstantVal)
+	if !ok {
+		return nil, errors.Errorf("invalid instant type %t", arg)
+	}
+	return i, nil
+}
+
+func durationArg(arg Val) (*DurationVal, error) {
+	d, ok := arg.(*DurationVal)
+	if !ok {
+		return nil, errors.Errorf("invalid duration type %t", arg)
+	}
+	return d, nil
+}
+
+// durationBuiltin makes a builtin turning a count of unit into a Duration.
+func durationBuiltin(name string, unit time.Duration) Item {
+	return Item{
+		Type: &Scheme{Forall: nil, Type: lamType(intType, durationType)},
+		Val: pureBuiltin(name, 1, func(args []Val) (Val, error) {
+			n, err := intArg(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return &DurationVal{Value: time.Duration(n.Value) * unit}, nil
+		}),
+	}
+}
+
+func timeItems() map[string]Item {
+	return map[string]Item{
+		"now": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(taskType(instantType, unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "now",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					return thunk("now", func(e *Evaluator) (Val, error) {
+						return &InstantVal{Value: e.program.Clock.Now()}, nil
+					}), nil
+				},
+			},
+		},
+		"sleep": {
+			Type: &Scheme{
+				Forall: []string{"e"},
+				Type:   lamType(intType, taskType(unitType, unionType(map[string]Type{}, "e"))),
+			},
+			Val: &Builtin{
+				Name: "sleep",
+				Impl: func(e *Evaluator, args []Val) (Val, error) {
+					if len(args) != 1 {
+						return nil, errors.Errorf("expecting 1 arguments, got %d", len(args))
+					}
+					ms, ok := args[0].(*Int)
+					if !ok {
+						return nil, errors.Errorf("invalid sleep duration type %t", args[0])
+					}
+
+					return thunk("sleep", func(e *Evaluator) (Val, error) {
+						err := e.program.Clock.Sleep(e.ctx, time.Duration(ms.Value)*time.Millisecond)
+						if err != nil {
+							return nil, err
+						}
+						return unitVal, nil
+					}), nil
+				},
+			},
+		},
+		"ms":      durationBuiltin("ms", time.Millisecond),
+		"seconds": durationBuiltin("seconds", time.Second),
+		"minutes": durationBuiltin("minutes", time.Minute),
+		"hours":   durationBuiltin("hours", time.Hour),
+		"to_ms": {
+			Type: &Scheme{Forall: nil, Type: lamType(durationType, intType)},
+			Val: pureBuiltin("to_ms", 1, func(args []Val) (Val, error) {
+				d, err := durationArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return &Int{Value: int(d.Value.Milliseconds())}, nil
+			}),
+		},
+		"from_unix_ms": {
+			Type: &Scheme{Forall: nil, Type: lamType(intType, instantType)},
+			Val: pureBuiltin("from_unix_ms", 1, func(args []Val) (Val, error) {
+				ms, err := intArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return &InstantVal{Value: time.UnixMilli(int64(ms.Value)).UTC()}, nil
+			}),
+		},
+		"to_unix_ms": {
+			Type: &Scheme{Forall: nil, Type: lamType(instantType, intType)},
+			Val: pureBuiltin("to_unix_ms", 1, func(args []Val) (Val, error) {
+				t, err := instantArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return &Int{Value: int(t.Value.UnixMilli())}, nil
+			}),
+		},
+		"time_add": {
+			Type: &Scheme{Forall: nil, Type: lamType(instantType, durationType, instantType)},
+			Val: pureBuiltin("time_add", 2, func(args []Val) (Val, error) {
+				t, err := instantArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				d, err := durationArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+				return &InstantVal{Value: t.Value.Add(d.Value)}, nil
+			}),
+		},
+		"time_sub": {
+			Type: &Scheme{Forall: nil, Type: lamType(instantType, instantType, durationType)},
+			Val: pureBuiltin("time_sub", 2, func(args []Val) (Val, error) {
+				t, err := instantArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				u, err := instantArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+				return &DurationVal{Value: t.Value.Sub(u.Value)}, nil
+			}),
+		},
+		"time_before": {
+			Type: &Scheme{Forall: nil, Type: lamType(instantType, instantType, boolType)},
+			Val: pureBuiltin("time_before", 2, func(args []Val) (Val, error) {
+				t, err := instantArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				u, err := instantArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+				return boolVal(t.Value.Before(u.Value)), nil
+			}),
+		},
+		"duration_add": {
+			Type: &Scheme{Forall: nil, Type: lamType(durationType, durationType, durationType)},
+			Val: pureBuiltin("duration_add", 2, func(args []Val) (Val, error) {
+				d, err := durationArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				f, err := durationArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+				return &DurationVal{Value: d.Value + f.Value}, nil
+			}),
+		},
+		"format_rfc3339": {
+			Type: &Scheme{Forall: nil, Type: lamType(instantType, strType)},
+			Val: pureBuiltin("format_rfc3339", 1, func(args []Val) (Val, error) {
+				t, err := instantArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				return &LitStr{Value: t.Value.Format(time.RFC3339)}, nil
+			}),
+		},
+		"parse_rfc3339": {
+			Type: &Scheme{Forall: nil, Type: lamType(strType, resultType(instantType))},
+			Val: pureBuiltin("parse_rfc3339", 1, func(args []Val) (Val, error) {
+				str, err := strArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				t, err := time.Parse(time.RFC3339, str.Value)
+				if err != nil {
+					return errResult(err), nil
+				}
+				return okResult(&InstantVal{Value: t}), nil
+			}),
+		},
+		"format_time": {
+			Type: &Scheme{Forall: nil, Type: lamType(instantType, strType, strType)},
+			Val: pureBuiltin("format_time", 2, func(args []Val) (Val, error) {
+				t, err := instantArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				layout, err := strArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+				return &LitStr{Value: t.Value.Format(layout.Value)}, nil
+			}),
+		},
+		"parse_time": {
+			Type: &Scheme{Forall: nil, Type: lamType(strType, strType, resultType(instantType))},
+			Val: pureBuiltin("parse_time", 2, func(args []Val) (Val, error) {
+				str, err := strArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				layout, err := strArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+				t, err := time.Parse(layout.Value, str.Value)
+				if err != nil {
+					return errResult(err), nil
+				}
+				return okResult(&InstantVal{Value: t}), nil
+			}),
+		},
+		"in_zone": {
+			Type: &Scheme{Forall: nil, Type: lamType(instantType, strType, resultType(instantType))},
+			Val: pureBuiltin("in_zone", 2, func(args []Val) (Val, error) {
+				t, err := instantArg(args[0])
+				if err != nil {
+					return nil, err
+				}
+				zone, err := strArg(args[1])
+				if err != nil {
+					return nil, err
+				}
+				loc, err := time.LoadLocation(zone.Value)
+				if err != nil {
+					return errResult(err), nil
+				}
+				return okResult(&InstantVal{Value: t.Value.In(loc)}), nil
+			}),
+		},
+	}
+}
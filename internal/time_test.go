@@ -0,0 +1,23 @@
+package internal
+
+import "testing"
+
+func TestTimeBuiltinsRejectWrongArguments(t *testing.T) {
+	items := timeItems()
+	for name, args := range map[string][]Val{
+		"to_ms":          {&Int{Value: 1}},
+		"seconds":        {&LitStr{Value: "1"}},
+		"time_add":       {&InstantVal{}, &Int{Value: 1}},
+		"time_before":    {&DurationVal{}, &InstantVal{}},
+		"format_time":    {&InstantVal{}, &Int{Value: 1}},
+		"parse_rfc3339":  {&Int{Value: 1}},
+		"in_zone":        {&LitStr{Value: "UTC"}, &LitStr{Value: "UTC"}},
+		"duration_add":   {&DurationVal{}, &InstantVal{}},
+		"format_rfc3339": {&DurationVal{}},
+	} {
+		builtin := items[name].Val.(*Builtin)
+		if _, err := builtin.Impl(nil, args); err == nil {
+			t.Errorf("%s: got no error", name)
+		}
+	}
+}
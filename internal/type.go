@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"maps"
+	"slices"
 	"sort"
 	"strings"
 
@@ -17,6 +18,8 @@ const strConsName = "Str"
 const taskConsName = "Task"
 const lambdaConsName = "Lam"
 const listConsName = "List"
+const tupleConsName = "Tuple"
+const bytesConsName = "Bytes"
 
 type Type interface {
 	typ()
@@ -44,6 +47,10 @@ func (t *TypeCons) Pretty(indent int) string {
 		args = append(args, arg.Pretty(indent))
 	}
 
+	if t.Name == tupleConsName {
+		return fmt.Sprintf("(%s)", strings.Join(args, ", "))
+	}
+
 	return fmt.Sprintf("%s<%s>", t.Name, strings.Join(args, ", "))
 }
 
@@ -152,6 +159,12 @@ func (t *TypeRec) apply(subst *Subst) Type {
 type Scheme struct {
 	Forall []string
 	Type   Type
+	// constraints are the constraints on variables of Forall, for builtins
+	// that take only some types
+	constraints map[string][]*constraint
+	// typeArg marks the binding of decode, whose uses record the type they
+	// are instantiated at, see recordTypeArg
+	typeArg bool
 }
 
 func (s *Scheme) Pretty(indent int) string {
@@ -168,8 +181,10 @@ func (s *Scheme) apply(subst *Subst) *Scheme {
 	}
 
 	return &Scheme{
-		Forall: s.Forall,
-		Type:   s.Type.apply(&Subst{Subst: limitedSubst}),
+		Forall:      s.Forall,
+		Type:        s.Type.apply(&Subst{Subst: limitedSubst}),
+		constraints: s.constraints,
+		typeArg:     s.typeArg,
 	}
 }
 
@@ -207,13 +222,28 @@ func bind(tvarName string, t Type) (*Subst, error) {
 
 }
 
+// bindVar binds a type variable, passing its constraints on to t.
+func (i *Inferrer) bindVar(tvarName string, t Type) (*Subst, error) {
+	subst, err := bind(tvarName, t)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range i.constraints[tvarName] {
+		if err := i.constrain(t, c); err != nil {
+			return nil, err
+		}
+	}
+	return subst, nil
+}
+
 func (i *Inferrer) unify(t1, t2 Type) (*Subst, error) {
 	if tvar, ok := t1.(*TypeVar); ok {
-		return bind(tvar.Name, t2)
+		return i.bindVar(tvar.Name, t2)
 	}
 
 	if tvar, ok := t2.(*TypeVar); ok {
-		return bind(tvar.Name, t1)
+		return i.bindVar(tvar.Name, t1)
 	}
 
 	if cons1, ok := t1.(*TypeCons); ok {
@@ -336,13 +366,30 @@ func generalize(t Type) *Scheme {
 	}
 }
 
+// generalizeIn is generalize for a binding inside env: the type variables
+// free in env belong to enclosing lambdas, so they are not quantified.
+func generalizeIn(env *TypeEnv, t Type) *Scheme {
+	free := t.freeVars()
+	free.Separate(env.freeVars())
+	forall := free.List()
+	sort.Strings(forall)
+	return &Scheme{
+		Forall: forall,
+		Type:   t,
+	}
+}
+
 type Inferrer struct {
 	program  *Program
 	varCount int
+	// typeArgs are the uses of decode whose TypeArg is yet to be resolved
+	typeArgs []*Var
+	// constraints are the constraints on type variables, by name
+	constraints map[string][]*constraint
 }
 
 func NewInferrer(program *Program) *Inferrer {
-	return &Inferrer{program: program, varCount: 0}
+	return &Inferrer{program: program, varCount: 0, constraints: map[string][]*constraint{}}
 }
 
 func (i *Inferrer) freshVar() *TypeVar {
@@ -354,7 +401,11 @@ func (i *Inferrer) freshVar() *TypeVar {
 func (i *Inferrer) instantiate(scheme *Scheme) Type {
 	subst := &Subst{Subst: map[string]Type{}}
 	for _, param := range scheme.Forall {
-		subst.Subst[param] = i.freshVar()
+		fresh := i.freshVar()
+		if cs := slices.Concat(i.constraints[param], scheme.constraints[param]); len(cs) > 0 {
+			i.constraints[fresh.Name] = cs
+		}
+		subst.Subst[param] = fresh
 	}
 	return scheme.Type.apply(subst)
 }
@@ -371,6 +422,14 @@ func (e *TypeEnv) apply(subst *Subst) *TypeEnv {
 	return result
 }
 
+func (e *TypeEnv) freeVars() *strset.Set {
+	result := strset.New()
+	for _, scheme := range e.Types {
+		result.Merge(scheme.freeVars())
+	}
+	return result
+}
+
 func (e *TypeEnv) extend(name string, scheme *Scheme) *TypeEnv {
 	cloned := maps.Clone(e.Types)
 	cloned[name] = scheme
@@ -395,6 +454,12 @@ func (i *Inferrer) Infer(expr Expr, env *TypeEnv) (subst *Subst, typ Type, err e
 		for _, part := range expr.Parts {
 			env = env.apply(subst)
 
+			var spec *FormatSpec
+			if format, ok := part.(*Format); ok {
+				part = format.Value
+				spec = format.Spec
+			}
+
 			s, t, err := i.Infer(part, env)
 			if err != nil {
 				return nil, nil, err
@@ -402,15 +467,19 @@ func (i *Inferrer) Infer(expr Expr, env *TypeEnv) (subst *Subst, typ Type, err e
 
 			subst = subst.compose(s)
 
-			s, err = i.unify(t, &TypeCons{
-				Name: strConsName,
-				Args: nil,
-			})
+			if spec != nil && spec.argType() != nil {
+				s, err = i.unify(t, spec.argType())
+				if err != nil {
+					return nil, nil, err
+				}
+
+				subst = subst.compose(s)
+			}
+
+			err = i.constrain(t.apply(subst), showable)
 			if err != nil {
 				return nil, nil, err
 			}
-
-			subst = subst.compose(s)
 		}
 
 		return subst, &TypeCons{
@@ -424,6 +493,7 @@ func (i *Inferrer) Infer(expr Expr, env *TypeEnv) (subst *Subst, typ Type, err e
 		}
 
 		t := i.instantiate(scheme)
+		i.recordTypeArg(expr, scheme, t)
 		return &Subst{Subst: map[string]Type{}}, t, nil
 	case *Lam:
 		newEnv := &TypeEnv{Types: maps.Clone(env.Types)}
@@ -513,6 +583,26 @@ func (i *Inferrer) Infer(expr Expr, env *TypeEnv) (subst *Subst, typ Type, err e
 			Name: listConsName,
 			Args: []Type{itemType},
 		}, nil
+	case *Tuple:
+		var items []Type
+		for _, item := range expr.Items {
+			env = env.apply(subst)
+
+			s, t, err := i.Infer(item, env)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			subst = subst.compose(s)
+			items = append(items, t)
+		}
+
+		result := &TypeCons{
+			Name: tupleConsName,
+			Args: items,
+		}
+
+		return subst, result.apply(subst), nil
 	case *Rec:
 		recType := &TypeRec{
 			Entries: map[string]Type{},
@@ -617,14 +707,10 @@ func (i *Inferrer) Infer(expr Expr, env *TypeEnv) (subst *Subst, typ Type, err e
 		subst = subst.compose(s)
 		valueType = valueType.apply(subst)
 
-		s, err = i.unify(valueType, expectedValueType)
+		s, err = i.unify(valueType, expectedValueType.apply(subst))
 		if err != nil {
 			return nil, nil, err
 		}
-		fmt.Println(valueType.Pretty(0))
-		fmt.Println(expectedValueType.Pretty(0))
-		fmt.Println(resultType.Pretty(0))
-		fmt.Println(s)
 		subst = subst.compose(s)
 
 		return subst, resultType.apply(subst), nil
@@ -648,7 +734,38 @@ func (i *Inferrer) Infer(expr Expr, env *TypeEnv) (subst *Subst, typ Type, err e
 					t = t.apply(subst)
 				}
 
-				env = env.extend(dec.Name, generalize(t))
+				env = env.extend(dec.Name, generalizeIn(env.apply(subst), t))
+			case *Destructure:
+				env = env.apply(subst)
+				s, t, err := i.Infer(dec.Value, env)
+				if err != nil {
+					return nil, nil, err
+				}
+				subst = subst.compose(s)
+
+				vars := map[string]*TypeVar{}
+				s, err = i.unify(i.patternType(dec.Pattern, vars), t.apply(subst))
+				if err != nil {
+					return nil, nil, err
+				}
+				subst = subst.compose(s)
+
+				env = env.apply(subst)
+				names := lo.Keys(vars)
+				sort.Strings(names)
+				for _, name := range names {
+					t := vars[name].apply(subst)
+					if scheme, has := env.Types[name]; has {
+						s, err = i.unify(i.instantiate(scheme), t)
+						if err != nil {
+							return nil, nil, err
+						}
+						subst = subst.compose(s)
+						t = t.apply(subst)
+					}
+
+					env = env.extend(name, generalizeIn(env.apply(subst), t))
+				}
 			case *TypeAnnotation:
 				if scheme, has := env.Types[dec.Name]; has {
 					t := i.instantiate(dec.Scheme)
@@ -667,7 +784,7 @@ func (i *Inferrer) Infer(expr Expr, env *TypeEnv) (subst *Subst, typ Type, err e
 				if err != nil {
 					return nil, nil, err
 				}
-				
+
 				if scheme, has := env.Types[dec.Name]; has {
 					t := i.instantiate(mod.Type)
 					s, err := i.unify(i.instantiate(scheme), t)
@@ -695,6 +812,77 @@ func (i *Inferrer) Infer(expr Expr, env *TypeEnv) (subst *Subst, typ Type, err e
 	return nil, nil, errors.Errorf("invalid expression type: %T", expr)
 }
 
+// constraint restricts the types a type variable can stand for. A type
+// variable keeps its constraints when it is bound, generalized or
+// instantiated, so that a function embedding its argument in a template
+// cannot be applied to a function.
+type constraint struct {
+	// message is the error for a rejected type, formatted with the type
+	message string
+	// rejects reports whether values of a type constructor are ruled out
+	rejects func(cons *TypeCons) bool
+}
+
+// showable is the constraint of values embedded in a string template: they
+// need a textual form.
+var showable = &constraint{
+	message: "cannot interpolate value of type %s",
+	rejects: func(cons *TypeCons) bool {
+		return cons.Name == lambdaConsName || cons.Name == taskConsName
+	},
+}
+
+// constrain checks t against c, recording c on the type variables in t.
+func (i *Inferrer) constrain(t Type, c *constraint) error {
+	switch t := t.(type) {
+	case *TypeVar:
+		if !slices.Contains(i.constraints[t.Name], c) {
+			i.constraints[t.Name] = append(i.constraints[t.Name], c)
+		}
+	case *TypeCons:
+		if c.rejects(t) {
+			return errors.Errorf(c.message, t.Pretty(0))
+		}
+		for _, arg := range t.Args {
+			if err := i.constrain(arg, c); err != nil {
+				return err
+			}
+		}
+	case *TypeRec:
+		for _, entry := range t.Entries {
+			if err := i.constrain(entry, c); err != nil {
+				return err
+			}
+		}
+		if t.RestVar != nil {
+			return i.constrain(t.RestVar, c)
+		}
+	}
+
+	return nil
+}
+
+// patternType returns the tuple type matched by pattern, recording a fresh
+// type variable for every name it binds.
+func (i *Inferrer) patternType(pattern *Tuple, vars map[string]*TypeVar) Type {
+	var items []Type
+	for _, item := range pattern.Items {
+		switch item := item.(type) {
+		case *Var:
+			fresh := i.freshVar()
+			vars[item.Name] = fresh
+			items = append(items, fresh)
+		case *Tuple:
+			items = append(items, i.patternType(item, vars))
+		}
+	}
+
+	return &TypeCons{
+		Name: tupleConsName,
+		Args: items,
+	}
+}
+
 func typeFromNode(node *tree_sitter.Node, source []byte) (Type, error) {
 	if node.HasError() {
 		return nil, errors.Errorf("parse error")
@@ -722,6 +910,18 @@ func typeFromNode(node *tree_sitter.Node, source []byte) (Type, error) {
 		}
 
 		return &TypeCons{Name: consName, Args: args}, nil
+	case "type_tuple":
+		var items []Type
+		for i := uint(0); i < node.NamedChildCount(); i++ {
+			typ, err := typeFromNode(node.NamedChild(i), source)
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, typ)
+		}
+
+		return &TypeCons{Name: tupleConsName, Args: items}, nil
 	case "type_rec", "type_union":
 		rec := &TypeRec{
 			Entries: map[string]Type{},
@@ -758,6 +958,21 @@ var unitType = &TypeRec{
 	Union:   false,
 }
 
+var intType = &TypeCons{
+	Name: intConsName,
+	Args: nil,
+}
+
+var strType = &TypeCons{
+	Name: strConsName,
+	Args: nil,
+}
+
+var bytesType = &TypeCons{
+	Name: bytesConsName,
+	Args: nil,
+}
+
 var neverType = &TypeRec{
 	Entries: map[string]Type{},
 	RestVar: nil,
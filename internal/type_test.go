@@ -0,0 +1,78 @@
+package internal
+
+import (
+	"context"
+	"testing"
+)
+
+// inferModule returns the type of a module.
+func inferModule(t *testing.T, source string) Type {
+	t.Helper()
+
+	program, err := NewProgram()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := program.Run(context.Background(), []byte(source), InlineModule)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mod.Type.Type
+}
+
+// checkFirstOfPair checks that t is Lam<[Pair (a, b)], a>.
+func checkFirstOfPair(t *testing.T, typ Type) {
+	t.Helper()
+
+	lam, ok := typ.(*TypeCons)
+	if !ok || lam.Name != lambdaConsName || len(lam.Args) != 2 {
+		t.Fatalf("got %s, want a function", typ.Pretty(0))
+	}
+	union, ok := lam.Args[0].(*TypeRec)
+	if !ok || !union.Union || len(union.Entries) != 1 {
+		t.Fatalf("got %s, want Lam<[Pair (a, b)], a>", typ.Pretty(0))
+	}
+	pair, ok := union.Entries["Pair"].(*TypeCons)
+	if !ok || pair.Name != tupleConsName || len(pair.Args) != 2 {
+		t.Fatalf("got %s, want Lam<[Pair (a, b)], a>", typ.Pretty(0))
+	}
+	first, ok := pair.Args[0].(*TypeVar)
+	result, resultOk := lam.Args[1].(*TypeVar)
+	if !ok || !resultOk || first.Name != result.Name {
+		t.Fatalf("got %s, want Lam<[Pair (a, b)], a>", typ.Pretty(0))
+	}
+}
+
+func TestInferWhenTuplePattern(t *testing.T) {
+	checkFirstOfPair(t, inferModule(t, `\p -> when p is Pair (a, b) -> a`))
+}
+
+func TestInferWhenTuplePatternInBinding(t *testing.T) {
+	checkFirstOfPair(t, inferModule(t, "f = \\p -> when p is Pair (a, b) -> a\nf"))
+}
+
+func TestInferDestructureInLambda(t *testing.T) {
+	typ := inferModule(t, "\\p -> ((a, b) = p\n  a)")
+
+	lam, ok := typ.(*TypeCons)
+	if !ok || lam.Name != lambdaConsName || len(lam.Args) != 2 {
+		t.Fatalf("got %s, want a function", typ.Pretty(0))
+	}
+	pair, ok := lam.Args[0].(*TypeCons)
+	if !ok || pair.Name != tupleConsName || len(pair.Args) != 2 {
+		t.Fatalf("got %s, want Lam<(a, b), a>", typ.Pretty(0))
+	}
+	first, ok := pair.Args[0].(*TypeVar)
+	result, resultOk := lam.Args[1].(*TypeVar)
+	if !ok || !resultOk || first.Name != result.Name {
+		t.Fatalf("got %s, want Lam<(a, b), a>", typ.Pretty(0))
+	}
+}
+
+func TestInferDestructurePolymorphic(t *testing.T) {
+	typ := inferModule(t, "((f, n) = (\\x -> x, 1)\n  (f(n), f(`s`)))")
+
+	if got := typ.Pretty(0); got != "(Int, Str)" {
+		t.Errorf("got %s, want (Int, Str)", got)
+	}
+}
@@ -1,8 +1,13 @@
 package internal
 
 import (
+	"context"
+	"encoding/hex"
 	"fmt"
 	"maps"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -14,19 +19,125 @@ type Val interface {
 	Pretty(indent int) string
 }
 
-func (i *Int) val()     {}
-func (s *LitStr) val()  {}
-func (l *ListVal) val() {}
-func (r *RecVal) val()  {}
-func (c *ConsVal) val() {}
-func (c *Closure) val() {}
-func (c *Builtin) val() {}
+func (i *Int) val()      {}
+func (s *LitStr) val()   {}
+func (l *ListVal) val()  {}
+func (t *TupleVal) val() {}
+func (b *BytesVal) val() {}
+func (r *RecVal) val()   {}
+func (c *ConsVal) val()  {}
+func (c *Closure) val()  {}
+func (c *Builtin) val()  {}
 
 var unitVal = &RecVal{
 	Entries: nil,
 }
 
-func errorVal(err error) *ConsVal {
+// show returns the text a value is interpolated as in a string template.
+// Strings are inserted as they are, everything else in its pretty form.
+func show(val Val) string {
+	if str, ok := val.(*LitStr); ok {
+		return str.Value
+	}
+
+	return val.Pretty(0)
+}
+
+// equal reports whether two values of the same type are equal. Channels and
+// refs are equal only to themselves, as two of them print alike; other
+// values are compared by their pretty form.
+func equal(a, b Val) bool {
+	switch a := a.(type) {
+	case *ChanVal:
+		return a == b
+	case *RefVal:
+		return a == b
+	case *ListVal:
+		other, ok := b.(*ListVal)
+		return ok && allEqual(a.Items, other.Items)
+	case *TupleVal:
+		other, ok := b.(*TupleVal)
+		return ok && allEqual(a.Items, other.Items)
+	case *RecVal:
+		other, ok := b.(*RecVal)
+		if !ok || len(a.Entries) != len(other.Entries) {
+			return false
+		}
+		for key, val := range a.Entries {
+			otherVal, has := other.Entries[key]
+			if !has || !equal(val, otherVal) {
+				return false
+			}
+		}
+		return true
+	case *ConsVal:
+		other, ok := b.(*ConsVal)
+		if !ok || a.Name != other.Name {
+			return false
+		}
+		return equal(payloadOrUnit(a), payloadOrUnit(other))
+	case *DictVal:
+		other, ok := b.(*DictVal)
+		if !ok || len(a.keys) != len(other.keys) {
+			return false
+		}
+		for _, key := range a.keys {
+			val, _ := a.get(key)
+			otherVal, has := other.get(key)
+			if !has || !equal(val, otherVal) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return a.Pretty(0) == b.Pretty(0)
+}
+
+// payloadOrUnit is the payload of cons, which is {} when left out.
+func payloadOrUnit(cons *ConsVal) Val {
+	if cons.Payload == nil {
+		return unitVal
+	}
+	return cons.Payload
+}
+
+func allEqual(a, b []Val) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// TaskError is the failure of a running task. It carries the value the task
+// failed with, so that handlers observe it unchanged.
+type TaskError struct {
+	Val Val
+}
+
+func (t *TaskError) Error() string {
+	return t.Val.Pretty(0)
+}
+
+// taskErrorf fails a task with `Err msg`.
+func taskErrorf(format string, args ...any) *TaskError {
+	return &TaskError{Val: &ConsVal{
+		Name:    "Err",
+		Payload: &LitStr{Value: fmt.Sprintf(format, args...)},
+	}}
+}
+
+func errorVal(err error) Val {
+	var taskErr *TaskError
+	if errors.As(err, &taskErr) {
+		return taskErr.Val
+	}
+
 	return &ConsVal{
 		Name:    "Err",
 		Payload: &LitStr{Value: err.Error()},
@@ -45,6 +156,27 @@ func (l *ListVal) Pretty(indent int) string {
 	return dent(indent, fmt.Sprintf("[%s]", strings.Join(items, ", ")))
 }
 
+// BytesVal is raw binary data, shown as hex.
+type BytesVal struct {
+	Value []byte
+}
+
+func (b *BytesVal) Pretty(indent int) string {
+	return dent(indent, "0x"+hex.EncodeToString(b.Value))
+}
+
+type TupleVal struct {
+	Items []Val
+}
+
+func (t *TupleVal) Pretty(indent int) string {
+	var items []string
+	for _, item := range t.Items {
+		items = append(items, item.Pretty(indent))
+	}
+	return dent(indent, fmt.Sprintf("(%s)", strings.Join(items, ", ")))
+}
+
 type RecVal struct {
 	Entries map[string]Val
 }
@@ -52,6 +184,7 @@ type RecVal struct {
 func (r *RecVal) Pretty(indent int) string {
 	var entries []string
 	keys := lo.Keys(r.Entries)
+	sort.Strings(keys)
 	for _, key := range keys {
 		val := r.Entries[key]
 		entries = append(entries, fmt.Sprintf("%s: %s", key, val.Pretty(indent)))
@@ -90,12 +223,94 @@ func (b *Builtin) Pretty(indent int) string {
 	return fmt.Sprintf("<builtin %s>", b.Name)
 }
 
+// Evaluator evaluates expressions. It holds no mutable state, so tasks can
+// run on several goroutines at once; builtins that change how the tasks they
+// run behave, like with_cwd, do so on a copy.
 type Evaluator struct {
 	program *Program
+	// dir is the working directory set by with_cwd, or empty for the
+	// process's own
+	dir string
+	// ctx is cancelled when the tasks being run are no longer needed
+	ctx context.Context
 }
 
 func NewEvaluator(program *Program) *Evaluator {
-	return &Evaluator{program: program}
+	return &Evaluator{program: program, ctx: context.Background()}
+}
+
+// withContext returns a copy of e that runs tasks under ctx.
+func (e *Evaluator) withContext(ctx context.Context) *Evaluator {
+	withCtx := *e
+	withCtx.ctx = ctx
+	return &withCtx
+}
+
+// detached returns a copy of e that keeps running after e is cancelled.
+func (e *Evaluator) detached() *Evaluator {
+	return e.withContext(context.WithoutCancel(e.ctx))
+}
+
+// bracket runs acquire, then use with the resource it gives, then release
+// with the resource, however use ends: with a result, a failure or
+// cancellation.
+func (e *Evaluator) bracket(acquire, use, release Val) (Val, error) {
+	resource, err := e.evalFn(acquire, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancellation between acquire and use must not leak the resource, so
+	// release is applied the way its task is run.
+	cleanup, err := e.detached().evalFn(release, []Val{resource})
+	if err != nil {
+		return nil, err
+	}
+
+	task, err := e.evalFn(use, []Val{resource})
+	if err != nil {
+		e.cleanup(cleanup)
+		return nil, err
+	}
+
+	return e.finally(task, cleanup)
+}
+
+// finally runs task and then cleanup, however task ends. A failure of task
+// is returned over one of cleanup.
+func (e *Evaluator) finally(task, cleanup Val) (Val, error) {
+	res, err := e.evalFn(task, nil)
+	cleanupErr := e.cleanup(cleanup)
+	if err != nil {
+		return nil, err
+	}
+	if cleanupErr != nil {
+		return nil, cleanupErr
+	}
+	return res, nil
+}
+
+// cleanup runs a task that releases resources. It runs even when e is
+// cancelled, as that is when releasing matters most.
+func (e *Evaluator) cleanup(task Val) error {
+	_, err := e.detached().evalFn(task, nil)
+	return err
+}
+
+// path resolves a path given to a builtin against the working directory.
+func (e *Evaluator) path(p string) string {
+	if e.dir == "" || filepath.IsAbs(p) {
+		return p
+	}
+	return filepath.Join(e.dir, p)
+}
+
+// cwd returns the working directory tasks run in.
+func (e *Evaluator) cwd() (string, error) {
+	if e.dir != "" {
+		return e.dir, nil
+	}
+	return os.Getwd()
 }
 
 func (e *Evaluator) Eval(expr Expr, env map[string]Val) (Val, error) {
@@ -107,17 +322,28 @@ func (e *Evaluator) Eval(expr Expr, env map[string]Val) (Val, error) {
 	case *Str:
 		sum := ""
 		for _, part := range expr.Parts {
+			var spec *FormatSpec
+			if format, ok := part.(*Format); ok {
+				part = format.Value
+				spec = format.Spec
+			}
+
 			val, err := e.Eval(part, env)
 			if err != nil {
 				return nil, err
 			}
 
-			str, ok := val.(*LitStr)
-			if !ok {
-				return nil, errors.Errorf("invalid value type for string template %t", val)
+			if spec != nil {
+				str, err := spec.format(val)
+				if err != nil {
+					return nil, err
+				}
+
+				sum += str
+				continue
 			}
 
-			sum += str.Value
+			sum += show(val)
 		}
 		return &LitStr{sum}, nil
 	case *Var:
@@ -126,6 +352,10 @@ func (e *Evaluator) Eval(expr Expr, env map[string]Val) (Val, error) {
 			return nil, errors.Errorf("undefined variable: %s", expr.Name)
 		}
 
+		if expr.TypeArg != nil {
+			return decodeBuiltin(expr.TypeArg), nil
+		}
+
 		return val, nil
 	case *Lam:
 		return &Closure{
@@ -161,6 +391,17 @@ func (e *Evaluator) Eval(expr Expr, env map[string]Val) (Val, error) {
 		}
 
 		return &ListVal{Items: vals}, nil
+	case *Tuple:
+		var vals []Val
+		for _, item := range expr.Items {
+			val, err := e.Eval(item, env)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, val)
+		}
+
+		return &TupleVal{Items: vals}, nil
 	case *Rec:
 		entries := map[string]Val{}
 		for _, entry := range expr.Entries {
@@ -232,6 +473,16 @@ func (e *Evaluator) Eval(expr Expr, env map[string]Val) (Val, error) {
 				}
 
 				blockEnv[decl.Name] = val
+			case *Destructure:
+				val, err := e.Eval(decl.Value, blockEnv)
+				if err != nil {
+					return nil, err
+				}
+
+				err = destructure(decl.Pattern, val, blockEnv)
+				if err != nil {
+					return nil, err
+				}
 			case *Import:
 				mod, err := e.program.Import(decl.Path)
 				if err != nil {
@@ -249,6 +500,10 @@ func (e *Evaluator) Eval(expr Expr, env map[string]Val) (Val, error) {
 }
 
 func (e *Evaluator) evalFn(fn Val, args []Val) (Val, error) {
+	if err := e.ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	if builtin, ok := fn.(*Builtin); ok {
 		return builtin.Impl(e, args)
 	}
@@ -270,6 +525,31 @@ func (e *Evaluator) evalFn(fn Val, args []Val) (Val, error) {
 	return e.Eval(clos.Body, newEnv)
 }
 
+func destructure(pattern *Tuple, val Val, env map[string]Val) error {
+	tuple, ok := val.(*TupleVal)
+	if !ok {
+		return errors.Errorf("invalid value type for tuple pattern %t", val)
+	}
+
+	if len(tuple.Items) != len(pattern.Items) {
+		return errors.Errorf("expecting tuple of %d items, got %d", len(pattern.Items), len(tuple.Items))
+	}
+
+	for i, item := range pattern.Items {
+		switch item := item.(type) {
+		case *Var:
+			env[item.Name] = tuple.Items[i]
+		case *Tuple:
+			err := destructure(item, tuple.Items[i], env)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func extend(env map[string]Val, name string, val Val) map[string]Val {
 	cloned := maps.Clone(env)
 	cloned[name] = val
@@ -0,0 +1,33 @@
+package internal
+
+import "testing"
+
+func TestEqualChannels(t *testing.T) {
+	a, b := newChanVal(1), newChanVal(1)
+
+	if equal(a, b) {
+		t.Error("two channels are equal")
+	}
+	if !equal(a, a) {
+		t.Error("a channel is not equal to itself")
+	}
+	if equal(&ListVal{Items: []Val{a}}, &ListVal{Items: []Val{b}}) {
+		t.Error("lists of two channels are equal")
+	}
+}
+
+func TestEqualRefs(t *testing.T) {
+	a, b := &RefVal{}, &RefVal{}
+	a.cell.Store(&refCell{val: &Int{Value: 1}})
+	b.cell.Store(&refCell{val: &Int{Value: 1}})
+
+	if equal(a, b) {
+		t.Error("two refs holding the same value are equal")
+	}
+	if !equal(a, a) {
+		t.Error("a ref is not equal to itself")
+	}
+	if equal(&TupleVal{Items: []Val{a}}, &TupleVal{Items: []Val{b}}) {
+		t.Error("tuples of two refs are equal")
+	}
+}
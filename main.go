@@ -1,10 +1,14 @@
 package main
 
 import (
+	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"fun/internal"
 	"log"
 	"os"
+	"os/signal"
 	"strings"
 
 	"github.com/chzyer/readline"
@@ -16,25 +20,81 @@ func main() {
 		log.Fatal(err)
 	}
 
-	if len(os.Args) > 1 {
-		filename := os.Args[1]
-
-		source, err := os.ReadFile(filename)
-		if err != nil {
-			println(err.Error())
+	seed := flag.Uint64("seed", 0, "seed the random number generator")
+	flag.Parse()
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == "seed" {
+			program.Seed(*seed)
 		}
+	})
+
+	if flag.NArg() > 0 {
+		program.Args = flag.Args()[1:]
+		os.Exit(runFile(program, flag.Arg(0)))
+	} else {
+		runReadlineRepl(program)
+	}
+}
+
+// Exit codes of `fun <file>`.
+const (
+	exitOK = iota
+	exitTaskFailed
+	exitReadError
+	exitParseError
+	exitTypeError
+	exitRuntimeError
+)
+
+// runFile runs the module in filename, printing its result to stdout and
+// diagnostics to stderr, and returns the exit code. Ctrl-C cancels the
+// running task, so that its brackets release what they acquired; a second
+// Ctrl-C kills the process if a release hangs.
+func runFile(program *internal.Program, filename string) int {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	context.AfterFunc(ctx, stop)
+
+	source, err := os.ReadFile(filename)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return exitReadError
+	}
+
+	mod, err := program.Run(ctx, source, filename)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return runErrorCode(err)
+	}
 
-		mod, err := program.Run(source, filename)
-		if err != nil {
-			fmt.Printf("Error: %s\n", err.Error())
-			return
+	val, typ, err := program.RunMain(ctx, mod)
+	if err != nil {
+		var taskErr *internal.TaskError
+		if errors.As(err, &taskErr) {
+			fmt.Fprintf(os.Stderr, "Task failed: %s\n", Pretty(val, typ, 0))
+			return exitTaskFailed
 		}
+		fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+		return exitRuntimeError
+	}
+
+	fmt.Println(Pretty(val, typ, 0))
+	return exitOK
+}
 
-		val, typ := program.EvalTask(mod)
+func runErrorCode(err error) int {
+	var stageErr *internal.StageError
+	if !errors.As(err, &stageErr) {
+		return exitRuntimeError
+	}
 
-		println(Pretty(val, typ, 0))
-	} else {
-		runReadlineRepl(program)
+	switch stageErr.Stage {
+	case internal.ParseStage:
+		return exitParseError
+	case internal.InferStage:
+		return exitTypeError
+	default:
+		return exitRuntimeError
 	}
 }
 
@@ -121,14 +181,33 @@ func Pretty(val internal.Val, scheme *internal.Scheme, indent int) string {
 	return fmt.Sprintf("%s : %s", val.Pretty(indent), scheme.Pretty(indent))
 }
 
+// evaluateInput runs a REPL entry. Ctrl-C while it runs cancels it and
+// returns to the prompt; a second one kills the REPL if a release hangs.
 func evaluateInput(program *internal.Program, input string) string {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	context.AfterFunc(ctx, stop)
+
 	source := []byte(input)
-	mod, err := program.Run(source, internal.InlineModule)
+	mod, err := program.Run(ctx, source, internal.InlineModule)
+	if ctx.Err() != nil {
+		return "Interrupted"
+	}
 	if err != nil {
 		return fmt.Sprintf("Error: %s", err)
 	}
 
-	val, typ := program.EvalTask(mod)
+	val, typ, err := program.EvalTask(ctx, mod)
+	if ctx.Err() != nil {
+		return "Interrupted"
+	}
+	if err != nil {
+		var taskErr *internal.TaskError
+		if errors.As(err, &taskErr) {
+			return fmt.Sprintf("Task failed: %s", Pretty(val, typ, 0))
+		}
+		return fmt.Sprintf("Error: %s", err)
+	}
 
 	result := Pretty(val, typ, 0)
 	if strings.TrimSpace(result) == "" {
@@ -25,10 +25,11 @@ module.exports = grammar({
   rules: {
     // TODO: add the actual grammar rules
     source_file: $ => $._inner_block,
-    _expr: $ => choice($.int, $.str, $.var, $.sym, $.app, $.iapp, $.lam, $.rec, $.prop, $.cons, $.when, $.list, $.block),
+    _expr: $ => choice($.int, $.str, $.var, $.sym, $.app, $.iapp, $.lam, $.rec, $.prop, $.cons, $.when, $.list, $.tuple, $.block, $.with),
     int: $ => /\d+/,
-    lit_str: $ => /[^`{}]+/,
-    str: $ => seq('`',repeat(choice($.lit_str, seq('{', $._expr, '}'))),'`'),
+    lit_str: $ => /([^`{}\\]|\\u\{[0-9a-fA-F]+\}|\\[^u])+/,
+    str: $ => seq('`',repeat(choice($.lit_str, seq('{', $._expr, optional(seq(':', $.fmt_spec)), '}'))),'`'),
+    fmt_spec: $ => /[-0-9.a-zA-Z]+/,
     var: $ => varName,
     cons_name: $ => consName,
     sym: $ => symbol,
@@ -38,20 +39,23 @@ module.exports = grammar({
     rec: $ => seq('{', sep(seq($.var, ':', $._expr), ','), '}'),
     prop: $ => prec.left(3, seq($._expr, '.',$.var)),
     cons: $ => prec.left(4,seq($.cons_name, optional($._expr))),
-    when: $ => prec.right(1,seq('when', $._expr, 'is', sep1(seq( $.cons_name, $.var, '->', $._expr), ';'), optional(seq('else', $._expr)))),
+    when: $ => prec.right(1,seq('when', $._expr, 'is', sep1(seq( $.cons_name, choice($.var, $.tuple), '->', $._expr), ';'), optional(seq('else', $._expr)))),
     list: $ => seq('[', sep($._expr, ','), ']'),
-    assign: $ => seq($.var, '=', $._expr),
-    bind: $ => seq($.var, '<-', $._expr),
+    tuple: $ => seq('(', $._expr, repeat1(seq(',', $._expr)), optional(','), ')'),
+    assign: $ => seq(choice($.var, $.tuple), '=', $._expr),
+    bind: $ => seq(choice($.var, $.tuple), '<-', $._expr),
     annot: $ => seq($.var, ':', $._type),
     import: $ => seq('import', $.var, 'from', '`', $.lit_str, '`') ,
     _decl: $ => choice($.assign, $.bind, $.annot, $.import),
     _inner_block: $ => seq(repeat(seq($._decl, choice('\n', '\\'))), $._expr),
     block: $ => prec.left(5,seq('(', $._inner_block, ')')),
+    with: $ => seq('with', $.var, repeat(seq('.', $.var)), $.block),
 
-    _type: $ => choice($.var, $.type_cons, $.type_rec, $.type_union),
+    _type: $ => choice($.var, $.type_cons, $.type_rec, $.type_union, $.type_tuple),
     type_cons: $ => seq($.cons_name, optional(seq('<', sep($._type, ', '), '>'))),
     type_rec: $ => seq('{', sep(seq($.var, ':', $._type), ','), '}'),
     type_union: $ => seq('[', sep(seq($.cons_name, $._type), ','), ']'),
+    type_tuple: $ => seq('(', $._type, repeat1(seq(',', $._type)), ')'),
 
     _comment: _ => token(seq('#', /.*/)),
   },
@@ -5,11 +5,11 @@
 #endif
 
 #define LANGUAGE_VERSION 14
-#define STATE_COUNT 398
+#define STATE_COUNT 741
 #define LARGE_STATE_COUNT 2
-#define SYMBOL_COUNT 62
+#define SYMBOL_COUNT 70
 #define ALIAS_COUNT 0
-#define TOKEN_COUNT 31
+#define TOKEN_COUNT 33
 #define EXTERNAL_TOKEN_COUNT 0
 #define FIELD_COUNT 0
 #define MAX_ALIAS_SEQUENCE_LENGTH 11
@@ -21,62 +21,70 @@ enum ts_symbol_identifiers {
   sym_lit_str = 3,
   anon_sym_BQUOTE = 4,
   anon_sym_LBRACE = 5,
-  anon_sym_RBRACE = 6,
-  sym_cons_name = 7,
-  sym_sym = 8,
-  anon_sym_LPAREN = 9,
-  anon_sym_COMMA = 10,
-  anon_sym_RPAREN = 11,
-  anon_sym_BSLASH = 12,
-  anon_sym_DASH_GT = 13,
-  anon_sym_COLON = 14,
-  anon_sym_DOT = 15,
-  anon_sym_when = 16,
-  anon_sym_is = 17,
-  anon_sym_SEMI = 18,
-  anon_sym_else = 19,
-  anon_sym_LBRACK = 20,
-  anon_sym_RBRACK = 21,
-  anon_sym_EQ = 22,
-  anon_sym_LT_DASH = 23,
-  anon_sym_import = 24,
-  anon_sym_from = 25,
-  anon_sym_LF = 26,
-  anon_sym_LT = 27,
-  anon_sym_COMMA2 = 28,
-  anon_sym_GT = 29,
-  sym__comment = 30,
-  sym_source_file = 31,
-  sym__expr = 32,
-  sym_str = 33,
-  sym_app = 34,
-  sym_iapp = 35,
-  sym_lam = 36,
-  sym_rec = 37,
-  sym_prop = 38,
-  sym_cons = 39,
-  sym_when = 40,
-  sym_list = 41,
-  sym_assign = 42,
-  sym_bind = 43,
-  sym_annot = 44,
-  sym_import = 45,
-  sym__decl = 46,
-  sym__inner_block = 47,
-  sym_block = 48,
-  sym__type = 49,
-  sym_type_cons = 50,
-  sym_type_rec = 51,
-  sym_type_union = 52,
-  aux_sym_str_repeat1 = 53,
-  aux_sym_app_repeat1 = 54,
-  aux_sym_lam_repeat1 = 55,
-  aux_sym_rec_repeat1 = 56,
-  aux_sym_when_repeat1 = 57,
-  aux_sym__inner_block_repeat1 = 58,
-  aux_sym_type_cons_repeat1 = 59,
-  aux_sym_type_rec_repeat1 = 60,
-  aux_sym_type_union_repeat1 = 61,
+  anon_sym_COLON = 6,
+  anon_sym_RBRACE = 7,
+  sym_fmt_spec = 8,
+  sym_cons_name = 9,
+  sym_sym = 10,
+  anon_sym_LPAREN = 11,
+  anon_sym_COMMA = 12,
+  anon_sym_RPAREN = 13,
+  anon_sym_BSLASH = 14,
+  anon_sym_DASH_GT = 15,
+  anon_sym_DOT = 16,
+  anon_sym_when = 17,
+  anon_sym_is = 18,
+  anon_sym_SEMI = 19,
+  anon_sym_else = 20,
+  anon_sym_LBRACK = 21,
+  anon_sym_RBRACK = 22,
+  anon_sym_EQ = 23,
+  anon_sym_LT_DASH = 24,
+  anon_sym_import = 25,
+  anon_sym_from = 26,
+  anon_sym_LF = 27,
+  anon_sym_with = 28,
+  anon_sym_LT = 29,
+  anon_sym_COMMA2 = 30,
+  anon_sym_GT = 31,
+  sym__comment = 32,
+  sym_source_file = 33,
+  sym__expr = 34,
+  sym_str = 35,
+  sym_app = 36,
+  sym_iapp = 37,
+  sym_lam = 38,
+  sym_rec = 39,
+  sym_prop = 40,
+  sym_cons = 41,
+  sym_when = 42,
+  sym_list = 43,
+  sym_tuple = 44,
+  sym_assign = 45,
+  sym_bind = 46,
+  sym_annot = 47,
+  sym_import = 48,
+  sym__decl = 49,
+  sym__inner_block = 50,
+  sym_block = 51,
+  sym_with = 52,
+  sym__type = 53,
+  sym_type_cons = 54,
+  sym_type_rec = 55,
+  sym_type_union = 56,
+  sym_type_tuple = 57,
+  aux_sym_str_repeat1 = 58,
+  aux_sym_app_repeat1 = 59,
+  aux_sym_lam_repeat1 = 60,
+  aux_sym_rec_repeat1 = 61,
+  aux_sym_when_repeat1 = 62,
+  aux_sym_tuple_repeat1 = 63,
+  aux_sym__inner_block_repeat1 = 64,
+  aux_sym_with_repeat1 = 65,
+  aux_sym_type_cons_repeat1 = 66,
+  aux_sym_type_rec_repeat1 = 67,
+  aux_sym_type_union_repeat1 = 68,
+  aux_sym_type_tuple_repeat1 = 69,
 };
 
 static const char * const ts_symbol_names[] = {
@@ -86,7 +94,9 @@ static const char * const ts_symbol_names[] = {
   [sym_lit_str] = "lit_str",
   [anon_sym_BQUOTE] = "`",
   [anon_sym_LBRACE] = "{",
+  [anon_sym_COLON] = ":",
   [anon_sym_RBRACE] = "}",
+  [sym_fmt_spec] = "fmt_spec",
   [sym_cons_name] = "cons_name",
   [sym_sym] = "sym",
   [anon_sym_LPAREN] = "(",
@@ -94,7 +104,6 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_RPAREN] = ")",
   [anon_sym_BSLASH] = "\\",
   [anon_sym_DASH_GT] = "->",
-  [anon_sym_COLON] = ":",
   [anon_sym_DOT] = ".",
   [anon_sym_when] = "when",
   [anon_sym_is] = "is",
@@ -107,6 +116,7 @@ static const char * const ts_symbol_names[] = {
   [anon_sym_import] = "import",
   [anon_sym_from] = "from",
   [anon_sym_LF] = "\n",
+  [anon_sym_with] = "with",
   [anon_sym_LT] = "<",
   [anon_sym_COMMA2] = ", ",
   [anon_sym_GT] = ">",
@@ -122,6 +132,7 @@ static const char * const ts_symbol_names[] = {
   [sym_cons] = "cons",
   [sym_when] = "when",
   [sym_list] = "list",
+  [sym_tuple] = "tuple",
   [sym_assign] = "assign",
   [sym_bind] = "bind",
   [sym_annot] = "annot",
@@ -129,19 +140,24 @@ static const char * const ts_symbol_names[] = {
   [sym__decl] = "_decl",
   [sym__inner_block] = "_inner_block",
   [sym_block] = "block",
+  [sym_with] = "with",
   [sym__type] = "_type",
   [sym_type_cons] = "type_cons",
   [sym_type_rec] = "type_rec",
   [sym_type_union] = "type_union",
+  [sym_type_tuple] = "type_tuple",
   [aux_sym_str_repeat1] = "str_repeat1",
   [aux_sym_app_repeat1] = "app_repeat1",
   [aux_sym_lam_repeat1] = "lam_repeat1",
   [aux_sym_rec_repeat1] = "rec_repeat1",
   [aux_sym_when_repeat1] = "when_repeat1",
+  [aux_sym_tuple_repeat1] = "tuple_repeat1",
   [aux_sym__inner_block_repeat1] = "_inner_block_repeat1",
+  [aux_sym_with_repeat1] = "with_repeat1",
   [aux_sym_type_cons_repeat1] = "type_cons_repeat1",
   [aux_sym_type_rec_repeat1] = "type_rec_repeat1",
   [aux_sym_type_union_repeat1] = "type_union_repeat1",
+  [aux_sym_type_tuple_repeat1] = "type_tuple_repeat1",
 };
 
 static const TSSymbol ts_symbol_map[] = {
@@ -151,7 +167,9 @@ static const TSSymbol ts_symbol_map[] = {
   [sym_lit_str] = sym_lit_str,
   [anon_sym_BQUOTE] = anon_sym_BQUOTE,
   [anon_sym_LBRACE] = anon_sym_LBRACE,
+  [anon_sym_COLON] = anon_sym_COLON,
   [anon_sym_RBRACE] = anon_sym_RBRACE,
+  [sym_fmt_spec] = sym_fmt_spec,
   [sym_cons_name] = sym_cons_name,
   [sym_sym] = sym_sym,
   [anon_sym_LPAREN] = anon_sym_LPAREN,
@@ -159,7 +177,6 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_RPAREN] = anon_sym_RPAREN,
   [anon_sym_BSLASH] = anon_sym_BSLASH,
   [anon_sym_DASH_GT] = anon_sym_DASH_GT,
-  [anon_sym_COLON] = anon_sym_COLON,
   [anon_sym_DOT] = anon_sym_DOT,
   [anon_sym_when] = anon_sym_when,
   [anon_sym_is] = anon_sym_is,
@@ -172,6 +189,7 @@ static const TSSymbol ts_symbol_map[] = {
   [anon_sym_import] = anon_sym_import,
   [anon_sym_from] = anon_sym_from,
   [anon_sym_LF] = anon_sym_LF,
+  [anon_sym_with] = anon_sym_with,
   [anon_sym_LT] = anon_sym_LT,
   [anon_sym_COMMA2] = anon_sym_COMMA2,
   [anon_sym_GT] = anon_sym_GT,
@@ -187,6 +205,7 @@ static const TSSymbol ts_symbol_map[] = {
   [sym_cons] = sym_cons,
   [sym_when] = sym_when,
   [sym_list] = sym_list,
+  [sym_tuple] = sym_tuple,
   [sym_assign] = sym_assign,
   [sym_bind] = sym_bind,
   [sym_annot] = sym_annot,
@@ -194,19 +213,24 @@ static const TSSymbol ts_symbol_map[] = {
   [sym__decl] = sym__decl,
   [sym__inner_block] = sym__inner_block,
   [sym_block] = sym_block,
+  [sym_with] = sym_with,
   [sym__type] = sym__type,
   [sym_type_cons] = sym_type_cons,
   [sym_type_rec] = sym_type_rec,
   [sym_type_union] = sym_type_union,
+  [sym_type_tuple] = sym_type_tuple,
   [aux_sym_str_repeat1] = aux_sym_str_repeat1,
   [aux_sym_app_repeat1] = aux_sym_app_repeat1,
   [aux_sym_lam_repeat1] = aux_sym_lam_repeat1,
   [aux_sym_rec_repeat1] = aux_sym_rec_repeat1,
   [aux_sym_when_repeat1] = aux_sym_when_repeat1,
+  [aux_sym_tuple_repeat1] = aux_sym_tuple_repeat1,
   [aux_sym__inner_block_repeat1] = aux_sym__inner_block_repeat1,
+  [aux_sym_with_repeat1] = aux_sym_with_repeat1,
   [aux_sym_type_cons_repeat1] = aux_sym_type_cons_repeat1,
   [aux_sym_type_rec_repeat1] = aux_sym_type_rec_repeat1,
   [aux_sym_type_union_repeat1] = aux_sym_type_union_repeat1,
+  [aux_sym_type_tuple_repeat1] = aux_sym_type_tuple_repeat1,
 };
 
 static const TSSymbolMetadata ts_symbol_metadata[] = {
@@ -234,10 +258,18 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_COLON] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_RBRACE] = {
     .visible = true,
     .named = false,
   },
+  [sym_fmt_spec] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_cons_name] = {
     .visible = true,
     .named = true,
@@ -266,10 +298,6 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
-  [anon_sym_COLON] = {
-    .visible = true,
-    .named = false,
-  },
   [anon_sym_DOT] = {
     .visible = true,
     .named = false,
@@ -318,6 +346,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = false,
   },
+  [anon_sym_with] = {
+    .visible = true,
+    .named = false,
+  },
   [anon_sym_LT] = {
     .visible = true,
     .named = false,
@@ -378,6 +410,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_tuple] = {
+    .visible = true,
+    .named = true,
+  },
   [sym_assign] = {
     .visible = true,
     .named = true,
@@ -406,6 +442,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_with] = {
+    .visible = true,
+    .named = true,
+  },
   [sym__type] = {
     .visible = false,
     .named = true,
@@ -422,6 +462,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = true,
     .named = true,
   },
+  [sym_type_tuple] = {
+    .visible = true,
+    .named = true,
+  },
   [aux_sym_str_repeat1] = {
     .visible = false,
     .named = false,
@@ -442,10 +486,18 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_tuple_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
   [aux_sym__inner_block_repeat1] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_with_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
   [aux_sym_type_cons_repeat1] = {
     .visible = false,
     .named = false,
@@ -458,6 +510,10 @@ static const TSSymbolMetadata ts_symbol_metadata[] = {
     .visible = false,
     .named = false,
   },
+  [aux_sym_type_tuple_repeat1] = {
+    .visible = false,
+    .named = false,
+  },
 };
 
 static const TSSymbol ts_alias_sequences[PRODUCTION_ID_COUNT][MAX_ALIAS_SEQUENCE_LENGTH] = {
@@ -473,20 +529,20 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [1] = 1,
   [2] = 2,
   [3] = 3,
-  [4] = 3,
-  [5] = 2,
+  [4] = 4,
+  [5] = 5,
   [6] = 6,
-  [7] = 2,
+  [7] = 7,
   [8] = 8,
   [9] = 9,
-  [10] = 2,
-  [11] = 8,
-  [12] = 9,
+  [10] = 10,
+  [11] = 11,
+  [12] = 12,
   [13] = 13,
   [14] = 14,
   [15] = 15,
-  [16] = 13,
-  [17] = 15,
+  [16] = 16,
+  [17] = 17,
   [18] = 18,
   [19] = 19,
   [20] = 20,
@@ -501,44 +557,44 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [29] = 29,
   [30] = 30,
   [31] = 31,
-  [32] = 19,
+  [32] = 32,
   [33] = 33,
   [34] = 34,
-  [35] = 21,
-  [36] = 24,
-  [37] = 26,
-  [38] = 27,
-  [39] = 28,
-  [40] = 29,
-  [41] = 31,
-  [42] = 19,
-  [43] = 33,
-  [44] = 27,
-  [45] = 28,
-  [46] = 27,
-  [47] = 28,
+  [35] = 35,
+  [36] = 36,
+  [37] = 37,
+  [38] = 38,
+  [39] = 39,
+  [40] = 40,
+  [41] = 41,
+  [42] = 42,
+  [43] = 43,
+  [44] = 44,
+  [45] = 45,
+  [46] = 46,
+  [47] = 47,
   [48] = 48,
-  [49] = 20,
-  [50] = 18,
-  [51] = 23,
-  [52] = 48,
-  [53] = 48,
-  [54] = 48,
-  [55] = 33,
-  [56] = 18,
-  [57] = 21,
-  [58] = 24,
-  [59] = 26,
-  [60] = 29,
-  [61] = 31,
-  [62] = 19,
-  [63] = 33,
-  [64] = 18,
-  [65] = 21,
-  [66] = 24,
-  [67] = 26,
-  [68] = 29,
-  [69] = 31,
+  [49] = 49,
+  [50] = 50,
+  [51] = 51,
+  [52] = 52,
+  [53] = 53,
+  [54] = 54,
+  [55] = 55,
+  [56] = 56,
+  [57] = 57,
+  [58] = 58,
+  [59] = 59,
+  [60] = 60,
+  [61] = 61,
+  [62] = 62,
+  [63] = 63,
+  [64] = 64,
+  [65] = 65,
+  [66] = 66,
+  [67] = 67,
+  [68] = 68,
+  [69] = 69,
   [70] = 70,
   [71] = 71,
   [72] = 72,
@@ -550,15 +606,15 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [78] = 78,
   [79] = 79,
   [80] = 80,
-  [81] = 77,
-  [82] = 73,
-  [83] = 74,
+  [81] = 81,
+  [82] = 82,
+  [83] = 83,
   [84] = 84,
   [85] = 85,
   [86] = 86,
   [87] = 87,
   [88] = 88,
-  [89] = 84,
+  [89] = 89,
   [90] = 90,
   [91] = 91,
   [92] = 92,
@@ -567,7 +623,7 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [95] = 95,
   [96] = 96,
   [97] = 97,
-  [98] = 78,
+  [98] = 98,
   [99] = 99,
   [100] = 100,
   [101] = 101,
@@ -575,274 +631,274 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [103] = 103,
   [104] = 104,
   [105] = 105,
-  [106] = 79,
+  [106] = 106,
   [107] = 107,
   [108] = 108,
   [109] = 109,
   [110] = 110,
   [111] = 111,
   [112] = 112,
-  [113] = 112,
-  [114] = 112,
+  [113] = 113,
+  [114] = 114,
   [115] = 115,
-  [116] = 102,
-  [117] = 97,
-  [118] = 94,
+  [116] = 116,
+  [117] = 117,
+  [118] = 118,
   [119] = 119,
-  [120] = 95,
+  [120] = 120,
   [121] = 121,
-  [122] = 99,
-  [123] = 92,
-  [124] = 121,
-  [125] = 100,
-  [126] = 121,
+  [122] = 122,
+  [123] = 123,
+  [124] = 124,
+  [125] = 125,
+  [126] = 126,
   [127] = 127,
-  [128] = 127,
+  [128] = 128,
   [129] = 129,
   [130] = 130,
   [131] = 131,
   [132] = 132,
-  [133] = 131,
+  [133] = 133,
   [134] = 134,
   [135] = 135,
-  [136] = 135,
+  [136] = 136,
   [137] = 137,
-  [138] = 131,
-  [139] = 127,
-  [140] = 137,
-  [141] = 137,
-  [142] = 135,
-  [143] = 75,
-  [144] = 102,
-  [145] = 103,
-  [146] = 107,
-  [147] = 80,
-  [148] = 86,
-  [149] = 90,
-  [150] = 91,
-  [151] = 85,
-  [152] = 101,
-  [153] = 105,
-  [154] = 108,
-  [155] = 88,
-  [156] = 76,
-  [157] = 87,
-  [158] = 111,
-  [159] = 72,
-  [160] = 93,
-  [161] = 96,
-  [162] = 78,
-  [163] = 104,
-  [164] = 79,
-  [165] = 109,
-  [166] = 78,
-  [167] = 79,
-  [168] = 92,
-  [169] = 94,
-  [170] = 95,
-  [171] = 97,
-  [172] = 99,
-  [173] = 100,
+  [138] = 138,
+  [139] = 139,
+  [140] = 140,
+  [141] = 141,
+  [142] = 142,
+  [143] = 143,
+  [144] = 144,
+  [145] = 145,
+  [146] = 146,
+  [147] = 147,
+  [148] = 148,
+  [149] = 149,
+  [150] = 150,
+  [151] = 151,
+  [152] = 152,
+  [153] = 153,
+  [154] = 154,
+  [155] = 155,
+  [156] = 156,
+  [157] = 157,
+  [158] = 158,
+  [159] = 159,
+  [160] = 160,
+  [161] = 161,
+  [162] = 162,
+  [163] = 163,
+  [164] = 164,
+  [165] = 165,
+  [166] = 166,
+  [167] = 167,
+  [168] = 168,
+  [169] = 169,
+  [170] = 170,
+  [171] = 171,
+  [172] = 172,
+  [173] = 173,
   [174] = 174,
-  [175] = 94,
-  [176] = 99,
+  [175] = 175,
+  [176] = 176,
   [177] = 177,
-  [178] = 100,
-  [179] = 102,
+  [178] = 178,
+  [179] = 179,
   [180] = 180,
-  [181] = 95,
+  [181] = 181,
   [182] = 182,
   [183] = 183,
   [184] = 184,
-  [185] = 177,
+  [185] = 185,
   [186] = 186,
-  [187] = 186,
+  [187] = 187,
   [188] = 188,
   [189] = 189,
-  [190] = 182,
-  [191] = 188,
+  [190] = 190,
+  [191] = 191,
   [192] = 192,
-  [193] = 189,
-  [194] = 182,
+  [193] = 193,
+  [194] = 194,
   [195] = 195,
   [196] = 196,
-  [197] = 189,
-  [198] = 92,
-  [199] = 97,
-  [200] = 184,
-  [201] = 174,
-  [202] = 183,
+  [197] = 197,
+  [198] = 198,
+  [199] = 199,
+  [200] = 200,
+  [201] = 201,
+  [202] = 202,
   [203] = 203,
   [204] = 204,
   [205] = 205,
-  [206] = 205,
-  [207] = 205,
+  [206] = 206,
+  [207] = 207,
   [208] = 208,
   [209] = 209,
   [210] = 210,
-  [211] = 204,
-  [212] = 205,
+  [211] = 211,
+  [212] = 212,
   [213] = 213,
   [214] = 214,
   [215] = 215,
-  [216] = 210,
+  [216] = 216,
   [217] = 217,
   [218] = 218,
   [219] = 219,
   [220] = 220,
-  [221] = 214,
+  [221] = 221,
   [222] = 222,
   [223] = 223,
   [224] = 224,
-  [225] = 220,
+  [225] = 225,
   [226] = 226,
-  [227] = 223,
-  [228] = 214,
-  [229] = 224,
+  [227] = 227,
+  [228] = 228,
+  [229] = 229,
   [230] = 230,
   [231] = 231,
   [232] = 232,
-  [233] = 223,
-  [234] = 224,
+  [233] = 233,
+  [234] = 234,
   [235] = 235,
   [236] = 236,
   [237] = 237,
   [238] = 238,
   [239] = 239,
-  [240] = 226,
+  [240] = 240,
   [241] = 241,
-  [242] = 226,
+  [242] = 242,
   [243] = 243,
   [244] = 244,
-  [245] = 226,
+  [245] = 245,
   [246] = 246,
   [247] = 247,
   [248] = 248,
-  [249] = 243,
-  [250] = 237,
+  [249] = 249,
+  [250] = 250,
   [251] = 251,
   [252] = 252,
-  [253] = 238,
-  [254] = 239,
+  [253] = 253,
+  [254] = 254,
   [255] = 255,
-  [256] = 241,
-  [257] = 218,
-  [258] = 243,
+  [256] = 256,
+  [257] = 257,
+  [258] = 258,
   [259] = 259,
   [260] = 260,
   [261] = 261,
-  [262] = 255,
+  [262] = 262,
   [263] = 263,
   [264] = 264,
-  [265] = 239,
+  [265] = 265,
   [266] = 266,
   [267] = 267,
   [268] = 268,
-  [269] = 246,
+  [269] = 269,
   [270] = 270,
   [271] = 271,
-  [272] = 219,
+  [272] = 272,
   [273] = 273,
   [274] = 274,
-  [275] = 273,
+  [275] = 275,
   [276] = 276,
-  [277] = 260,
-  [278] = 261,
-  [279] = 264,
-  [280] = 266,
-  [281] = 267,
-  [282] = 268,
-  [283] = 270,
-  [284] = 271,
-  [285] = 219,
-  [286] = 273,
-  [287] = 274,
+  [277] = 277,
+  [278] = 278,
+  [279] = 279,
+  [280] = 280,
+  [281] = 281,
+  [282] = 282,
+  [283] = 283,
+  [284] = 284,
+  [285] = 285,
+  [286] = 286,
+  [287] = 287,
   [288] = 288,
-  [289] = 276,
-  [290] = 232,
-  [291] = 260,
+  [289] = 289,
+  [290] = 290,
+  [291] = 291,
   [292] = 292,
-  [293] = 261,
+  [293] = 293,
   [294] = 294,
   [295] = 295,
   [296] = 296,
-  [297] = 241,
+  [297] = 297,
   [298] = 298,
   [299] = 299,
   [300] = 300,
-  [301] = 298,
-  [302] = 299,
-  [303] = 300,
-  [304] = 247,
-  [305] = 230,
-  [306] = 298,
-  [307] = 259,
-  [308] = 300,
-  [309] = 231,
-  [310] = 259,
-  [311] = 274,
+  [301] = 301,
+  [302] = 302,
+  [303] = 303,
+  [304] = 304,
+  [305] = 305,
+  [306] = 306,
+  [307] = 307,
+  [308] = 308,
+  [309] = 309,
+  [310] = 310,
+  [311] = 311,
   [312] = 312,
-  [313] = 251,
-  [314] = 263,
-  [315] = 264,
-  [316] = 300,
-  [317] = 235,
-  [318] = 266,
-  [319] = 267,
-  [320] = 236,
-  [321] = 268,
-  [322] = 252,
+  [313] = 313,
+  [314] = 314,
+  [315] = 315,
+  [316] = 316,
+  [317] = 317,
+  [318] = 318,
+  [319] = 319,
+  [320] = 320,
+  [321] = 321,
+  [322] = 322,
   [323] = 323,
-  [324] = 298,
+  [324] = 324,
   [325] = 325,
-  [326] = 231,
-  [327] = 248,
-  [328] = 232,
-  [329] = 299,
-  [330] = 237,
-  [331] = 230,
-  [332] = 270,
-  [333] = 218,
-  [334] = 235,
-  [335] = 248,
-  [336] = 251,
-  [337] = 252,
-  [338] = 255,
-  [339] = 276,
-  [340] = 271,
-  [341] = 236,
-  [342] = 248,
-  [343] = 251,
-  [344] = 252,
-  [345] = 255,
-  [346] = 276,
-  [347] = 238,
+  [326] = 326,
+  [327] = 327,
+  [328] = 328,
+  [329] = 329,
+  [330] = 330,
+  [331] = 331,
+  [332] = 332,
+  [333] = 333,
+  [334] = 334,
+  [335] = 335,
+  [336] = 336,
+  [337] = 337,
+  [338] = 338,
+  [339] = 339,
+  [340] = 340,
+  [341] = 341,
+  [342] = 342,
+  [343] = 343,
+  [344] = 344,
+  [345] = 345,
+  [346] = 346,
+  [347] = 347,
   [348] = 348,
   [349] = 349,
   [350] = 350,
   [351] = 351,
   [352] = 352,
-  [353] = 348,
+  [353] = 353,
   [354] = 354,
   [355] = 355,
   [356] = 356,
   [357] = 357,
   [358] = 358,
   [359] = 359,
-  [360] = 354,
+  [360] = 360,
   [361] = 361,
   [362] = 362,
   [363] = 363,
   [364] = 364,
-  [365] = 354,
-  [366] = 348,
-  [367] = 361,
+  [365] = 365,
+  [366] = 366,
+  [367] = 367,
   [368] = 368,
-  [369] = 364,
+  [369] = 369,
   [370] = 370,
   [371] = 371,
   [372] = 372,
-  [373] = 364,
+  [373] = 373,
   [374] = 374,
   [375] = 375,
   [376] = 376,
@@ -850,23 +906,366 @@ static const TSStateId ts_primary_state_ids[STATE_COUNT] = {
   [378] = 378,
   [379] = 379,
   [380] = 380,
-  [381] = 368,
-  [382] = 354,
-  [383] = 348,
-  [384] = 364,
-  [385] = 371,
-  [386] = 361,
-  [387] = 375,
-  [388] = 368,
+  [381] = 381,
+  [382] = 382,
+  [383] = 383,
+  [384] = 384,
+  [385] = 385,
+  [386] = 386,
+  [387] = 387,
+  [388] = 388,
   [389] = 389,
   [390] = 390,
-  [391] = 371,
+  [391] = 391,
   [392] = 392,
-  [393] = 351,
-  [394] = 377,
+  [393] = 393,
+  [394] = 394,
   [395] = 395,
-  [396] = 362,
-  [397] = 361,
+  [396] = 396,
+  [397] = 397,
+  [398] = 398,
+  [399] = 399,
+  [400] = 400,
+  [401] = 401,
+  [402] = 402,
+  [403] = 403,
+  [404] = 404,
+  [405] = 405,
+  [406] = 406,
+  [407] = 407,
+  [408] = 408,
+  [409] = 409,
+  [410] = 410,
+  [411] = 411,
+  [412] = 412,
+  [413] = 413,
+  [414] = 414,
+  [415] = 415,
+  [416] = 416,
+  [417] = 417,
+  [418] = 418,
+  [419] = 419,
+  [420] = 420,
+  [421] = 421,
+  [422] = 422,
+  [423] = 423,
+  [424] = 424,
+  [425] = 425,
+  [426] = 426,
+  [427] = 427,
+  [428] = 428,
+  [429] = 429,
+  [430] = 430,
+  [431] = 431,
+  [432] = 432,
+  [433] = 433,
+  [434] = 434,
+  [435] = 435,
+  [436] = 436,
+  [437] = 437,
+  [438] = 438,
+  [439] = 439,
+  [440] = 440,
+  [441] = 441,
+  [442] = 442,
+  [443] = 443,
+  [444] = 444,
+  [445] = 445,
+  [446] = 446,
+  [447] = 447,
+  [448] = 448,
+  [449] = 449,
+  [450] = 450,
+  [451] = 451,
+  [452] = 452,
+  [453] = 453,
+  [454] = 454,
+  [455] = 455,
+  [456] = 456,
+  [457] = 457,
+  [458] = 458,
+  [459] = 459,
+  [460] = 460,
+  [461] = 461,
+  [462] = 462,
+  [463] = 463,
+  [464] = 464,
+  [465] = 465,
+  [466] = 466,
+  [467] = 467,
+  [468] = 468,
+  [469] = 469,
+  [470] = 470,
+  [471] = 471,
+  [472] = 472,
+  [473] = 473,
+  [474] = 474,
+  [475] = 475,
+  [476] = 476,
+  [477] = 477,
+  [478] = 478,
+  [479] = 479,
+  [480] = 480,
+  [481] = 481,
+  [482] = 482,
+  [483] = 483,
+  [484] = 484,
+  [485] = 485,
+  [486] = 486,
+  [487] = 487,
+  [488] = 488,
+  [489] = 489,
+  [490] = 490,
+  [491] = 491,
+  [492] = 492,
+  [493] = 493,
+  [494] = 494,
+  [495] = 495,
+  [496] = 496,
+  [497] = 497,
+  [498] = 498,
+  [499] = 499,
+  [500] = 500,
+  [501] = 501,
+  [502] = 502,
+  [503] = 503,
+  [504] = 504,
+  [505] = 505,
+  [506] = 506,
+  [507] = 507,
+  [508] = 508,
+  [509] = 509,
+  [510] = 510,
+  [511] = 511,
+  [512] = 512,
+  [513] = 513,
+  [514] = 514,
+  [515] = 515,
+  [516] = 516,
+  [517] = 517,
+  [518] = 518,
+  [519] = 519,
+  [520] = 520,
+  [521] = 521,
+  [522] = 522,
+  [523] = 523,
+  [524] = 524,
+  [525] = 525,
+  [526] = 526,
+  [527] = 527,
+  [528] = 528,
+  [529] = 529,
+  [530] = 530,
+  [531] = 531,
+  [532] = 532,
+  [533] = 533,
+  [534] = 534,
+  [535] = 535,
+  [536] = 536,
+  [537] = 537,
+  [538] = 538,
+  [539] = 539,
+  [540] = 540,
+  [541] = 541,
+  [542] = 542,
+  [543] = 543,
+  [544] = 544,
+  [545] = 545,
+  [546] = 546,
+  [547] = 547,
+  [548] = 548,
+  [549] = 549,
+  [550] = 550,
+  [551] = 551,
+  [552] = 552,
+  [553] = 553,
+  [554] = 554,
+  [555] = 555,
+  [556] = 556,
+  [557] = 557,
+  [558] = 558,
+  [559] = 559,
+  [560] = 560,
+  [561] = 561,
+  [562] = 562,
+  [563] = 563,
+  [564] = 564,
+  [565] = 565,
+  [566] = 566,
+  [567] = 567,
+  [568] = 568,
+  [569] = 569,
+  [570] = 570,
+  [571] = 571,
+  [572] = 572,
+  [573] = 573,
+  [574] = 574,
+  [575] = 575,
+  [576] = 576,
+  [577] = 577,
+  [578] = 578,
+  [579] = 579,
+  [580] = 580,
+  [581] = 581,
+  [582] = 582,
+  [583] = 583,
+  [584] = 584,
+  [585] = 585,
+  [586] = 586,
+  [587] = 587,
+  [588] = 588,
+  [589] = 589,
+  [590] = 590,
+  [591] = 591,
+  [592] = 592,
+  [593] = 593,
+  [594] = 594,
+  [595] = 595,
+  [596] = 596,
+  [597] = 597,
+  [598] = 598,
+  [599] = 599,
+  [600] = 600,
+  [601] = 601,
+  [602] = 602,
+  [603] = 603,
+  [604] = 604,
+  [605] = 605,
+  [606] = 606,
+  [607] = 607,
+  [608] = 608,
+  [609] = 609,
+  [610] = 610,
+  [611] = 611,
+  [612] = 612,
+  [613] = 613,
+  [614] = 614,
+  [615] = 615,
+  [616] = 616,
+  [617] = 617,
+  [618] = 618,
+  [619] = 619,
+  [620] = 620,
+  [621] = 621,
+  [622] = 622,
+  [623] = 623,
+  [624] = 624,
+  [625] = 625,
+  [626] = 626,
+  [627] = 627,
+  [628] = 628,
+  [629] = 629,
+  [630] = 630,
+  [631] = 631,
+  [632] = 632,
+  [633] = 633,
+  [634] = 634,
+  [635] = 635,
+  [636] = 636,
+  [637] = 637,
+  [638] = 638,
+  [639] = 639,
+  [640] = 640,
+  [641] = 641,
+  [642] = 642,
+  [643] = 643,
+  [644] = 644,
+  [645] = 645,
+  [646] = 646,
+  [647] = 647,
+  [648] = 648,
+  [649] = 649,
+  [650] = 650,
+  [651] = 651,
+  [652] = 652,
+  [653] = 653,
+  [654] = 654,
+  [655] = 655,
+  [656] = 656,
+  [657] = 657,
+  [658] = 658,
+  [659] = 659,
+  [660] = 660,
+  [661] = 661,
+  [662] = 662,
+  [663] = 663,
+  [664] = 664,
+  [665] = 665,
+  [666] = 666,
+  [667] = 667,
+  [668] = 668,
+  [669] = 669,
+  [670] = 670,
+  [671] = 671,
+  [672] = 672,
+  [673] = 673,
+  [674] = 674,
+  [675] = 675,
+  [676] = 676,
+  [677] = 677,
+  [678] = 678,
+  [679] = 679,
+  [680] = 680,
+  [681] = 681,
+  [682] = 682,
+  [683] = 683,
+  [684] = 684,
+  [685] = 685,
+  [686] = 686,
+  [687] = 687,
+  [688] = 688,
+  [689] = 689,
+  [690] = 690,
+  [691] = 691,
+  [692] = 692,
+  [693] = 693,
+  [694] = 694,
+  [695] = 695,
+  [696] = 696,
+  [697] = 697,
+  [698] = 698,
+  [699] = 699,
+  [700] = 700,
+  [701] = 701,
+  [702] = 702,
+  [703] = 703,
+  [704] = 704,
+  [705] = 705,
+  [706] = 706,
+  [707] = 707,
+  [708] = 708,
+  [709] = 709,
+  [710] = 710,
+  [711] = 711,
+  [712] = 712,
+  [713] = 713,
+  [714] = 714,
+  [715] = 715,
+  [716] = 716,
+  [717] = 717,
+  [718] = 718,
+  [719] = 719,
+  [720] = 720,
+  [721] = 721,
+  [722] = 722,
+  [723] = 723,
+  [724] = 724,
+  [725] = 725,
+  [726] = 726,
+  [727] = 727,
+  [728] = 728,
+  [729] = 729,
+  [730] = 730,
+  [731] = 731,
+  [732] = 732,
+  [733] = 733,
+  [734] = 734,
+  [735] = 735,
+  [736] = 736,
+  [737] = 737,
+  [738] = 738,
+  [739] = 739,
+  [740] = 740,
 };
 
 static bool ts_lex(TSLexer *lexer, TSStateId state) {
@@ -874,53 +1273,50 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
   eof = lexer->eof(lexer);
   switch (state) {
     case 0:
-      if (eof) ADVANCE(13);
-      if (lookahead == '\r') SKIP(0);
-      if (lookahead == '#') ADVANCE(44);
-      if (lookahead == '(') ADVANCE(25);
-      if (lookahead == ')') ADVANCE(27);
-      if (lookahead == ',') ADVANCE(26);
-      if (lookahead == '-') ADVANCE(9);
-      if (lookahead == '.') ADVANCE(31);
-      if (lookahead == ':') ADVANCE(30);
-      if (lookahead == ';') ADVANCE(32);
-      if (lookahead == '<') ADVANCE(41);
-      if (lookahead == '=') ADVANCE(35);
-      if (lookahead == '>') ADVANCE(43);
-      if (lookahead == '[') ADVANCE(33);
-      if (lookahead == '\\') ADVANCE(28);
-      if (lookahead == ']') ADVANCE(34);
-      if (lookahead == '_') ADVANCE(10);
-      if (lookahead == '`') ADVANCE(18);
-      if (lookahead == '{') ADVANCE(19);
-      if (lookahead == '}') ADVANCE(20);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '-') ADVANCE(61);
+      if (lookahead == '.') ADVANCE(62);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (lookahead == ':') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(65);
+      if (lookahead == '<') ADVANCE(66);
+      if (lookahead == '=') ADVANCE(67);
+      if (lookahead == '>') ADVANCE(68);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
           lookahead == 0xfeff) SKIP(0);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
-      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(22);
-      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(21);
       END_STATE();
     case 1:
-      if (lookahead == '\n') ADVANCE(39);
-      if (lookahead == '\r') SKIP(1);
-      if (lookahead == '#') ADVANCE(44);
-      if (lookahead == '(') ADVANCE(25);
-      if (lookahead == '.') ADVANCE(31);
-      if (lookahead == ';') ADVANCE(32);
-      if (lookahead == '[') ADVANCE(33);
-      if (lookahead == '\\') ADVANCE(28);
-      if (lookahead == '_') ADVANCE(10);
-      if (lookahead == '`') ADVANCE(18);
-      if (lookahead == '{') ADVANCE(19);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
           lookahead == 0xfeff) SKIP(1);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
       if (lookahead == '!' ||
           ('$' <= lookahead && lookahead <= '&') ||
           lookahead == '*' ||
@@ -929,215 +1325,434 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
           ('<' <= lookahead && lookahead <= '>') ||
           lookahead == '@' ||
           lookahead == '^' ||
-          lookahead == '~') ADVANCE(24);
-      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(22);
-      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(21);
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 2:
-      if (lookahead == '\n') ADVANCE(39);
-      if (lookahead == '\r') SKIP(2);
-      if (lookahead == '#') ADVANCE(44);
-      if (lookahead == '<') ADVANCE(40);
-      if (lookahead == '\\') ADVANCE(28);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == ':') ADVANCE(64);
+      if (lookahead == '<') ADVANCE(79);
+      if (lookahead == '=') ADVANCE(80);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
           lookahead == 0xfeff) SKIP(2);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          lookahead == '>' ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 3:
-      if (lookahead == '\r') ADVANCE(16);
-      if (lookahead == '#') ADVANCE(15);
-      if (lookahead == '`') ADVANCE(18);
-      if (lookahead == '{') ADVANCE(19);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == ':') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(65);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
-          lookahead == 0xfeff) ADVANCE(16);
-      if (lookahead != 0 &&
-          lookahead != '}') ADVANCE(17);
+          lookahead == 0xfeff) SKIP(3);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 4:
-      if (lookahead == '\r') SKIP(4);
-      if (lookahead == '#') ADVANCE(44);
-      if (lookahead == ',') ADVANCE(26);
-      if (lookahead == '<') ADVANCE(40);
-      if (lookahead == ']') ADVANCE(34);
-      if (lookahead == '}') ADVANCE(20);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
-          lookahead == ' ' ||
-          lookahead == 0x200b ||
-          lookahead == 0x2060 ||
-          lookahead == 0xfeff) SKIP(4);
+      if (lookahead == '#') ADVANCE(83);
+      if (lookahead == '\\') ADVANCE(84);
+      if (lookahead == '`') ADVANCE(74);
+      if (lookahead == '{') ADVANCE(76);
+      if ((0x1 <= lookahead && lookahead <= '"') ||
+          ('$' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(82);
       END_STATE();
     case 5:
-      if (lookahead == '\r') SKIP(5);
-      if (lookahead == '#') ADVANCE(44);
-      if (lookahead == ',') ADVANCE(7);
-      if (lookahead == '<') ADVANCE(40);
-      if (lookahead == '>') ADVANCE(43);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
           lookahead == 0xfeff) SKIP(5);
       END_STATE();
     case 6:
-      if (lookahead == '\r') SKIP(6);
-      if (lookahead == '#') ADVANCE(44);
-      if (lookahead == ':') ADVANCE(30);
-      if (lookahead == '<') ADVANCE(8);
-      if (lookahead == '=') ADVANCE(35);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (lookahead == ':') ADVANCE(64);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
           lookahead == 0xfeff) SKIP(6);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 7:
-      if (lookahead == ' ') ADVANCE(42);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '-') ADVANCE(61);
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(7);
       END_STATE();
     case 8:
-      if (lookahead == '-') ADVANCE(37);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(8);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 9:
-      if (lookahead == '>') ADVANCE(29);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(9);
       END_STATE();
     case 10:
-      if (lookahead == '_') ADVANCE(10);
-      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(22);
-      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(21);
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(10);
       END_STATE();
     case 11:
-      if (eof) ADVANCE(13);
-      if (lookahead == '\r') SKIP(11);
-      if (lookahead == '#') ADVANCE(44);
-      if (lookahead == '(') ADVANCE(25);
-      if (lookahead == ')') ADVANCE(27);
-      if (lookahead == ',') ADVANCE(26);
-      if (lookahead == '.') ADVANCE(31);
-      if (lookahead == ';') ADVANCE(32);
-      if (lookahead == '[') ADVANCE(33);
-      if (lookahead == '\\') ADVANCE(28);
-      if (lookahead == ']') ADVANCE(34);
-      if (lookahead == '_') ADVANCE(10);
-      if (lookahead == '`') ADVANCE(18);
-      if (lookahead == '{') ADVANCE(19);
-      if (lookahead == '}') ADVANCE(20);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == '.') ADVANCE(62);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
           lookahead == 0xfeff) SKIP(11);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
       if (lookahead == '!' ||
           ('$' <= lookahead && lookahead <= '&') ||
-          ('*' <= lookahead && lookahead <= '-') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
           ('<' <= lookahead && lookahead <= '>') ||
           lookahead == '@' ||
           lookahead == '^' ||
-          lookahead == '~') ADVANCE(24);
-      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(22);
-      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(21);
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 12:
-      if (eof) ADVANCE(13);
-      if (lookahead == '\r') SKIP(12);
-      if (lookahead == '#') ADVANCE(44);
-      if (lookahead == '(') ADVANCE(25);
-      if (lookahead == ')') ADVANCE(27);
-      if (lookahead == ',') ADVANCE(7);
-      if (lookahead == '.') ADVANCE(31);
-      if (lookahead == ':') ADVANCE(30);
-      if (lookahead == '<') ADVANCE(23);
-      if (lookahead == '=') ADVANCE(36);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == '<') ADVANCE(79);
+      if (lookahead == '=') ADVANCE(80);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
           lookahead == 0xfeff) SKIP(12);
       if (lookahead == '!' ||
           ('$' <= lookahead && lookahead <= '&') ||
-          ('*' <= lookahead && lookahead <= '-') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
           lookahead == '>' ||
           lookahead == '@' ||
           lookahead == '^' ||
-          lookahead == '~') ADVANCE(24);
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 13:
-      ACCEPT_TOKEN(ts_builtin_sym_end);
+      if (lookahead == '\n') ADVANCE(85);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '\t' ||
+          ('\v' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(13);
       END_STATE();
     case 14:
-      ACCEPT_TOKEN(sym_int);
-      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(14);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '_') ADVANCE(73);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(14);
       END_STATE();
     case 15:
-      ACCEPT_TOKEN(sym_lit_str);
-      if (lookahead == '\n') ADVANCE(17);
-      if (lookahead == '`' ||
-          lookahead == '{' ||
-          lookahead == '}') ADVANCE(44);
-      if (lookahead != 0) ADVANCE(15);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ':') ADVANCE(64);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(15);
       END_STATE();
     case 16:
-      ACCEPT_TOKEN(sym_lit_str);
-      if (lookahead == '\r') ADVANCE(16);
-      if (lookahead == '#') ADVANCE(15);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
-          lookahead == 0xfeff) ADVANCE(16);
-      if (lookahead != 0 &&
-          lookahead != '`' &&
-          lookahead != '{' &&
-          lookahead != '}') ADVANCE(17);
+          lookahead == 0xfeff) SKIP(16);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 17:
-      ACCEPT_TOKEN(sym_lit_str);
-      if (lookahead != 0 &&
-          lookahead != '`' &&
-          lookahead != '{' &&
-          lookahead != '}') ADVANCE(17);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ')') ADVANCE(59);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(17);
       END_STATE();
     case 18:
-      ACCEPT_TOKEN(anon_sym_BQUOTE);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '-') ADVANCE(61);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(18);
       END_STATE();
     case 19:
-      ACCEPT_TOKEN(anon_sym_LBRACE);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(19);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 20:
-      ACCEPT_TOKEN(anon_sym_RBRACE);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(20);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 21:
-      ACCEPT_TOKEN(sym_var);
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'Z') ||
-          lookahead == '_' ||
-          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(21);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == ']') ADVANCE(72);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(21);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 22:
-      ACCEPT_TOKEN(sym_cons_name);
-      if (('0' <= lookahead && lookahead <= '9') ||
-          ('A' <= lookahead && lookahead <= 'Z') ||
-          lookahead == '_' ||
-          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(22);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(22);
       END_STATE();
     case 23:
-      ACCEPT_TOKEN(sym_sym);
-      if (lookahead == '-') ADVANCE(38);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(23);
       if (lookahead == '!' ||
           ('$' <= lookahead && lookahead <= '&') ||
           lookahead == '*' ||
           lookahead == '+' ||
+          lookahead == '-' ||
           ('<' <= lookahead && lookahead <= '>') ||
           lookahead == '@' ||
           lookahead == '^' ||
-          lookahead == '~') ADVANCE(24);
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 24:
-      ACCEPT_TOKEN(sym_sym);
+      if (lookahead == '\n') ADVANCE(85);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '<') ADVANCE(66);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '\t' ||
+          ('\v' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(24);
+      END_STATE();
+    case 25:
+      if (lookahead == '#') ADVANCE(57);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '_') ADVANCE(86);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(25);
+      END_STATE();
+    case 26:
+      if (lookahead == '\n') ADVANCE(85);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '\t' ||
+          ('\v' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(26);
       if (lookahead == '!' ||
           ('$' <= lookahead && lookahead <= '&') ||
           lookahead == '*' ||
@@ -1146,43 +1761,48 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
           ('<' <= lookahead && lookahead <= '>') ||
           lookahead == '@' ||
           lookahead == '^' ||
-          lookahead == '~') ADVANCE(24);
-      END_STATE();
-    case 25:
-      ACCEPT_TOKEN(anon_sym_LPAREN);
-      END_STATE();
-    case 26:
-      ACCEPT_TOKEN(anon_sym_COMMA);
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 27:
-      ACCEPT_TOKEN(anon_sym_RPAREN);
+      if (lookahead == '\n') ADVANCE(85);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (lookahead == '\t' ||
+          ('\v' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(27);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
     case 28:
-      ACCEPT_TOKEN(anon_sym_BSLASH);
-      END_STATE();
-    case 29:
-      ACCEPT_TOKEN(anon_sym_DASH_GT);
-      END_STATE();
-    case 30:
-      ACCEPT_TOKEN(anon_sym_COLON);
-      END_STATE();
-    case 31:
-      ACCEPT_TOKEN(anon_sym_DOT);
-      END_STATE();
-    case 32:
-      ACCEPT_TOKEN(anon_sym_SEMI);
-      END_STATE();
-    case 33:
-      ACCEPT_TOKEN(anon_sym_LBRACK);
-      END_STATE();
-    case 34:
-      ACCEPT_TOKEN(anon_sym_RBRACK);
-      END_STATE();
-    case 35:
-      ACCEPT_TOKEN(anon_sym_EQ);
-      END_STATE();
-    case 36:
-      ACCEPT_TOKEN(anon_sym_EQ);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == ':') ADVANCE(64);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(28);
       if (lookahead == '!' ||
           ('$' <= lookahead && lookahead <= '&') ||
           lookahead == '*' ||
@@ -1191,13 +1811,33 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
           ('<' <= lookahead && lookahead <= '>') ||
           lookahead == '@' ||
           lookahead == '^' ||
-          lookahead == '~') ADVANCE(24);
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 37:
-      ACCEPT_TOKEN(anon_sym_LT_DASH);
+    case 29:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(29);
       END_STATE();
-    case 38:
-      ACCEPT_TOKEN(anon_sym_LT_DASH);
+    case 30:
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == ':') ADVANCE(64);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(30);
       if (lookahead == '!' ||
           ('$' <= lookahead && lookahead <= '&') ||
           lookahead == '*' ||
@@ -1206,630 +1846,6291 @@ static bool ts_lex(TSLexer *lexer, TSStateId state) {
           ('<' <= lookahead && lookahead <= '>') ||
           lookahead == '@' ||
           lookahead == '^' ||
-          lookahead == '~') ADVANCE(24);
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 39:
-      ACCEPT_TOKEN(anon_sym_LF);
-      if (lookahead == '\n') ADVANCE(39);
+    case 31:
+      if (lookahead == '#') ADVANCE(57);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '_') ADVANCE(86);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(31);
       END_STATE();
-    case 40:
-      ACCEPT_TOKEN(anon_sym_LT);
+    case 32:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '`') ADVANCE(74);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(32);
       END_STATE();
-    case 41:
-      ACCEPT_TOKEN(anon_sym_LT);
-      if (lookahead == '-') ADVANCE(37);
+    case 33:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '>') ADVANCE(68);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '_') ADVANCE(73);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(33);
+      END_STATE();
+    case 34:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(34);
+      END_STATE();
+    case 35:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '<') ADVANCE(66);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(35);
+      END_STATE();
+    case 36:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ',') ADVANCE(60);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(36);
+      END_STATE();
+    case 37:
+      if (lookahead == '#') ADVANCE(57);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(37);
+      if (lookahead == '-' ||
+          lookahead == '.' ||
+          ('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'Z') ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(87);
+      END_STATE();
+    case 38:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(38);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
+      END_STATE();
+    case 39:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(39);
+      END_STATE();
+    case 40:
+      if (lookahead == '#') ADVANCE(83);
+      if (lookahead == '\\') ADVANCE(84);
+      if ((0x1 <= lookahead && lookahead <= '"') ||
+          ('$' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(82);
+      END_STATE();
+    case 41:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ',') ADVANCE(88);
+      if (lookahead == '>') ADVANCE(68);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(41);
       END_STATE();
     case 42:
-      ACCEPT_TOKEN(anon_sym_COMMA2);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ',') ADVANCE(88);
+      if (lookahead == '<') ADVANCE(66);
+      if (lookahead == '>') ADVANCE(68);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(42);
       END_STATE();
     case 43:
-      ACCEPT_TOKEN(anon_sym_GT);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == ']') ADVANCE(72);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(43);
       END_STATE();
     case 44:
-      ACCEPT_TOKEN(sym__comment);
-      if (lookahead != 0 &&
-          lookahead != '\n') ADVANCE(44);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(44);
       END_STATE();
-    default:
-      return false;
-  }
-}
-
-static bool ts_lex_keywords(TSLexer *lexer, TSStateId state) {
-  START_LEXER();
-  eof = lexer->eof(lexer);
-  switch (state) {
-    case 0:
-      if (lookahead == '\r') SKIP(0);
-      if (lookahead == 'e') ADVANCE(1);
-      if (lookahead == 'f') ADVANCE(2);
-      if (lookahead == 'i') ADVANCE(3);
-      if (lookahead == 'w') ADVANCE(4);
-      if (('\t' <= lookahead && lookahead <= '\f') ||
+    case 45:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '-') ADVANCE(61);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
           lookahead == ' ' ||
           lookahead == 0x200b ||
           lookahead == 0x2060 ||
-          lookahead == 0xfeff) SKIP(0);
+          lookahead == 0xfeff) SKIP(45);
       END_STATE();
-    case 1:
-      if (lookahead == 'l') ADVANCE(5);
+    case 46:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(46);
       END_STATE();
-    case 2:
-      if (lookahead == 'r') ADVANCE(6);
+    case 47:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(47);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 3:
-      if (lookahead == 'm') ADVANCE(7);
-      if (lookahead == 's') ADVANCE(8);
+    case 48:
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (lookahead == ':') ADVANCE(64);
+      if (lookahead == ';') ADVANCE(65);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(48);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 4:
-      if (lookahead == 'h') ADVANCE(9);
+    case 49:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (lookahead == ';') ADVANCE(65);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(49);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 5:
-      if (lookahead == 's') ADVANCE(10);
+    case 50:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == ';') ADVANCE(65);
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(50);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 6:
-      if (lookahead == 'o') ADVANCE(11);
+    case 51:
+      if (eof) ADVANCE(56);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == ')') ADVANCE(59);
+      if (lookahead == ',') ADVANCE(60);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == ':') ADVANCE(64);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == ']') ADVANCE(72);
+      if (lookahead == '_') ADVANCE(73);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '}') ADVANCE(77);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(51);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 7:
-      if (lookahead == 'p') ADVANCE(12);
+    case 52:
+      if (lookahead == '\n') ADVANCE(85);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (lookahead == ';') ADVANCE(65);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '\t' ||
+          ('\v' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(52);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 8:
-      ACCEPT_TOKEN(anon_sym_is);
+    case 53:
+      if (lookahead == '\n') ADVANCE(85);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
+      if (lookahead == ';') ADVANCE(65);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '[') ADVANCE(70);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '_') ADVANCE(73);
+      if (lookahead == '`') ADVANCE(74);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '{') ADVANCE(76);
+      if (lookahead == '\t' ||
+          ('\v' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(53);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 9:
-      if (lookahead == 'e') ADVANCE(13);
+    case 54:
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '_') ADVANCE(73);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(54);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 10:
-      if (lookahead == 'e') ADVANCE(14);
+    case 55:
+      if (lookahead == '\n') ADVANCE(85);
+      if (lookahead == '#') ADVANCE(57);
+      if (lookahead == '(') ADVANCE(58);
+      if (lookahead == '.') ADVANCE(62);
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '\\') ADVANCE(71);
+      if (lookahead == '_') ADVANCE(73);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      if (lookahead == '\t' ||
+          ('\v' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(55);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
       END_STATE();
-    case 11:
-      if (lookahead == 'm') ADVANCE(15);
+    case 56:
+      ACCEPT_TOKEN(ts_builtin_sym_end);
       END_STATE();
-    case 12:
-      if (lookahead == 'o') ADVANCE(16);
+    case 57:
+      ACCEPT_TOKEN(sym__comment);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(89);
       END_STATE();
-    case 13:
-      if (lookahead == 'n') ADVANCE(17);
+    case 58:
+      ACCEPT_TOKEN(anon_sym_LPAREN);
       END_STATE();
-    case 14:
-      ACCEPT_TOKEN(anon_sym_else);
+    case 59:
+      ACCEPT_TOKEN(anon_sym_RPAREN);
       END_STATE();
-    case 15:
-      ACCEPT_TOKEN(anon_sym_from);
+    case 60:
+      ACCEPT_TOKEN(anon_sym_COMMA);
       END_STATE();
-    case 16:
-      if (lookahead == 'r') ADVANCE(18);
+    case 61:
+      if (lookahead == '>') ADVANCE(90);
       END_STATE();
-    case 17:
-      ACCEPT_TOKEN(anon_sym_when);
+    case 62:
+      ACCEPT_TOKEN(anon_sym_DOT);
       END_STATE();
-    case 18:
-      if (lookahead == 't') ADVANCE(19);
+    case 63:
+      ACCEPT_TOKEN(sym_int);
+      if (('0' <= lookahead && lookahead <= '9')) ADVANCE(63);
       END_STATE();
-    case 19:
-      ACCEPT_TOKEN(anon_sym_import);
+    case 64:
+      ACCEPT_TOKEN(anon_sym_COLON);
       END_STATE();
-    default:
-      return false;
-  }
-}
-
-static const TSLexMode ts_lex_modes[STATE_COUNT] = {
-  [0] = {.lex_state = 0},
-  [1] = {.lex_state = 11},
-  [2] = {.lex_state = 11},
-  [3] = {.lex_state = 11},
-  [4] = {.lex_state = 11},
-  [5] = {.lex_state = 11},
-  [6] = {.lex_state = 11},
-  [7] = {.lex_state = 1},
-  [8] = {.lex_state = 11},
-  [9] = {.lex_state = 11},
-  [10] = {.lex_state = 1},
-  [11] = {.lex_state = 11},
-  [12] = {.lex_state = 11},
-  [13] = {.lex_state = 11},
-  [14] = {.lex_state = 11},
-  [15] = {.lex_state = 11},
-  [16] = {.lex_state = 11},
-  [17] = {.lex_state = 11},
-  [18] = {.lex_state = 11},
-  [19] = {.lex_state = 11},
-  [20] = {.lex_state = 11},
-  [21] = {.lex_state = 11},
-  [22] = {.lex_state = 11},
-  [23] = {.lex_state = 11},
-  [24] = {.lex_state = 11},
-  [25] = {.lex_state = 11},
-  [26] = {.lex_state = 11},
-  [27] = {.lex_state = 11},
-  [28] = {.lex_state = 11},
-  [29] = {.lex_state = 11},
-  [30] = {.lex_state = 11},
-  [31] = {.lex_state = 11},
-  [32] = {.lex_state = 11},
-  [33] = {.lex_state = 11},
-  [34] = {.lex_state = 11},
-  [35] = {.lex_state = 11},
-  [36] = {.lex_state = 11},
-  [37] = {.lex_state = 11},
-  [38] = {.lex_state = 11},
-  [39] = {.lex_state = 11},
-  [40] = {.lex_state = 11},
-  [41] = {.lex_state = 11},
-  [42] = {.lex_state = 11},
-  [43] = {.lex_state = 11},
-  [44] = {.lex_state = 11},
-  [45] = {.lex_state = 11},
-  [46] = {.lex_state = 11},
-  [47] = {.lex_state = 11},
-  [48] = {.lex_state = 11},
-  [49] = {.lex_state = 11},
-  [50] = {.lex_state = 11},
-  [51] = {.lex_state = 11},
-  [52] = {.lex_state = 11},
-  [53] = {.lex_state = 11},
-  [54] = {.lex_state = 11},
-  [55] = {.lex_state = 11},
-  [56] = {.lex_state = 11},
-  [57] = {.lex_state = 11},
-  [58] = {.lex_state = 11},
-  [59] = {.lex_state = 11},
-  [60] = {.lex_state = 11},
-  [61] = {.lex_state = 11},
-  [62] = {.lex_state = 11},
-  [63] = {.lex_state = 11},
-  [64] = {.lex_state = 11},
-  [65] = {.lex_state = 11},
-  [66] = {.lex_state = 11},
-  [67] = {.lex_state = 11},
-  [68] = {.lex_state = 11},
-  [69] = {.lex_state = 11},
-  [70] = {.lex_state = 11},
-  [71] = {.lex_state = 11},
-  [72] = {.lex_state = 11},
-  [73] = {.lex_state = 11},
-  [74] = {.lex_state = 11},
-  [75] = {.lex_state = 11},
-  [76] = {.lex_state = 11},
-  [77] = {.lex_state = 11},
-  [78] = {.lex_state = 11},
-  [79] = {.lex_state = 11},
-  [80] = {.lex_state = 11},
-  [81] = {.lex_state = 11},
-  [82] = {.lex_state = 11},
-  [83] = {.lex_state = 11},
-  [84] = {.lex_state = 11},
-  [85] = {.lex_state = 11},
-  [86] = {.lex_state = 11},
-  [87] = {.lex_state = 11},
-  [88] = {.lex_state = 11},
-  [89] = {.lex_state = 11},
-  [90] = {.lex_state = 11},
-  [91] = {.lex_state = 11},
-  [92] = {.lex_state = 11},
-  [93] = {.lex_state = 11},
-  [94] = {.lex_state = 11},
-  [95] = {.lex_state = 11},
-  [96] = {.lex_state = 11},
-  [97] = {.lex_state = 11},
-  [98] = {.lex_state = 11},
-  [99] = {.lex_state = 11},
-  [100] = {.lex_state = 11},
-  [101] = {.lex_state = 11},
-  [102] = {.lex_state = 11},
-  [103] = {.lex_state = 11},
-  [104] = {.lex_state = 11},
-  [105] = {.lex_state = 11},
-  [106] = {.lex_state = 11},
-  [107] = {.lex_state = 11},
-  [108] = {.lex_state = 11},
-  [109] = {.lex_state = 11},
-  [110] = {.lex_state = 11},
-  [111] = {.lex_state = 11},
-  [112] = {.lex_state = 0},
-  [113] = {.lex_state = 0},
-  [114] = {.lex_state = 0},
-  [115] = {.lex_state = 11},
-  [116] = {.lex_state = 11},
-  [117] = {.lex_state = 11},
-  [118] = {.lex_state = 11},
-  [119] = {.lex_state = 0},
-  [120] = {.lex_state = 11},
-  [121] = {.lex_state = 0},
-  [122] = {.lex_state = 11},
-  [123] = {.lex_state = 11},
-  [124] = {.lex_state = 0},
-  [125] = {.lex_state = 11},
-  [126] = {.lex_state = 0},
-  [127] = {.lex_state = 0},
-  [128] = {.lex_state = 0},
-  [129] = {.lex_state = 0},
-  [130] = {.lex_state = 12},
-  [131] = {.lex_state = 0},
-  [132] = {.lex_state = 0},
-  [133] = {.lex_state = 0},
-  [134] = {.lex_state = 0},
-  [135] = {.lex_state = 0},
-  [136] = {.lex_state = 0},
-  [137] = {.lex_state = 0},
-  [138] = {.lex_state = 0},
-  [139] = {.lex_state = 0},
-  [140] = {.lex_state = 0},
-  [141] = {.lex_state = 0},
-  [142] = {.lex_state = 0},
-  [143] = {.lex_state = 1},
-  [144] = {.lex_state = 1},
-  [145] = {.lex_state = 1},
-  [146] = {.lex_state = 1},
-  [147] = {.lex_state = 1},
-  [148] = {.lex_state = 1},
-  [149] = {.lex_state = 1},
-  [150] = {.lex_state = 1},
-  [151] = {.lex_state = 1},
-  [152] = {.lex_state = 1},
-  [153] = {.lex_state = 1},
-  [154] = {.lex_state = 1},
-  [155] = {.lex_state = 1},
-  [156] = {.lex_state = 1},
-  [157] = {.lex_state = 1},
-  [158] = {.lex_state = 1},
-  [159] = {.lex_state = 1},
-  [160] = {.lex_state = 1},
-  [161] = {.lex_state = 1},
-  [162] = {.lex_state = 1},
-  [163] = {.lex_state = 1},
-  [164] = {.lex_state = 1},
-  [165] = {.lex_state = 1},
-  [166] = {.lex_state = 1},
-  [167] = {.lex_state = 1},
-  [168] = {.lex_state = 1},
-  [169] = {.lex_state = 1},
-  [170] = {.lex_state = 1},
-  [171] = {.lex_state = 1},
-  [172] = {.lex_state = 1},
-  [173] = {.lex_state = 1},
-  [174] = {.lex_state = 11},
-  [175] = {.lex_state = 1},
-  [176] = {.lex_state = 1},
-  [177] = {.lex_state = 11},
-  [178] = {.lex_state = 1},
-  [179] = {.lex_state = 1},
-  [180] = {.lex_state = 11},
-  [181] = {.lex_state = 1},
-  [182] = {.lex_state = 0},
-  [183] = {.lex_state = 11},
-  [184] = {.lex_state = 11},
-  [185] = {.lex_state = 11},
-  [186] = {.lex_state = 11},
-  [187] = {.lex_state = 11},
-  [188] = {.lex_state = 11},
-  [189] = {.lex_state = 0},
-  [190] = {.lex_state = 0},
-  [191] = {.lex_state = 11},
-  [192] = {.lex_state = 11},
-  [193] = {.lex_state = 0},
-  [194] = {.lex_state = 0},
-  [195] = {.lex_state = 1},
-  [196] = {.lex_state = 1},
-  [197] = {.lex_state = 0},
-  [198] = {.lex_state = 1},
-  [199] = {.lex_state = 1},
-  [200] = {.lex_state = 11},
-  [201] = {.lex_state = 11},
-  [202] = {.lex_state = 11},
-  [203] = {.lex_state = 11},
-  [204] = {.lex_state = 3},
-  [205] = {.lex_state = 11},
-  [206] = {.lex_state = 11},
-  [207] = {.lex_state = 11},
-  [208] = {.lex_state = 3},
-  [209] = {.lex_state = 11},
-  [210] = {.lex_state = 3},
-  [211] = {.lex_state = 3},
-  [212] = {.lex_state = 11},
-  [213] = {.lex_state = 11},
-  [214] = {.lex_state = 4},
-  [215] = {.lex_state = 11},
-  [216] = {.lex_state = 3},
-  [217] = {.lex_state = 0},
-  [218] = {.lex_state = 0},
-  [219] = {.lex_state = 0},
-  [220] = {.lex_state = 0},
-  [221] = {.lex_state = 5},
-  [222] = {.lex_state = 6},
-  [223] = {.lex_state = 0},
-  [224] = {.lex_state = 0},
-  [225] = {.lex_state = 0},
-  [226] = {.lex_state = 0},
-  [227] = {.lex_state = 0},
-  [228] = {.lex_state = 2},
-  [229] = {.lex_state = 0},
-  [230] = {.lex_state = 0},
-  [231] = {.lex_state = 0},
-  [232] = {.lex_state = 0},
-  [233] = {.lex_state = 0},
-  [234] = {.lex_state = 0},
-  [235] = {.lex_state = 0},
-  [236] = {.lex_state = 0},
-  [237] = {.lex_state = 0},
-  [238] = {.lex_state = 0},
-  [239] = {.lex_state = 0},
-  [240] = {.lex_state = 0},
-  [241] = {.lex_state = 0},
-  [242] = {.lex_state = 0},
-  [243] = {.lex_state = 0},
-  [244] = {.lex_state = 3},
-  [245] = {.lex_state = 0},
-  [246] = {.lex_state = 0},
-  [247] = {.lex_state = 0},
-  [248] = {.lex_state = 0},
-  [249] = {.lex_state = 1},
-  [250] = {.lex_state = 5},
-  [251] = {.lex_state = 0},
-  [252] = {.lex_state = 0},
-  [253] = {.lex_state = 5},
-  [254] = {.lex_state = 5},
-  [255] = {.lex_state = 0},
-  [256] = {.lex_state = 5},
-  [257] = {.lex_state = 5},
-  [258] = {.lex_state = 5},
-  [259] = {.lex_state = 0},
-  [260] = {.lex_state = 5},
-  [261] = {.lex_state = 0},
-  [262] = {.lex_state = 0},
-  [263] = {.lex_state = 0},
-  [264] = {.lex_state = 0},
-  [265] = {.lex_state = 1},
-  [266] = {.lex_state = 5},
-  [267] = {.lex_state = 0},
-  [268] = {.lex_state = 0},
-  [269] = {.lex_state = 0},
-  [270] = {.lex_state = 0},
-  [271] = {.lex_state = 0},
-  [272] = {.lex_state = 5},
-  [273] = {.lex_state = 0},
-  [274] = {.lex_state = 0},
-  [275] = {.lex_state = 0},
-  [276] = {.lex_state = 0},
-  [277] = {.lex_state = 5},
-  [278] = {.lex_state = 0},
-  [279] = {.lex_state = 0},
-  [280] = {.lex_state = 5},
-  [281] = {.lex_state = 0},
-  [282] = {.lex_state = 0},
-  [283] = {.lex_state = 0},
-  [284] = {.lex_state = 0},
-  [285] = {.lex_state = 1},
-  [286] = {.lex_state = 0},
-  [287] = {.lex_state = 0},
-  [288] = {.lex_state = 0},
-  [289] = {.lex_state = 0},
-  [290] = {.lex_state = 1},
-  [291] = {.lex_state = 5},
-  [292] = {.lex_state = 0},
-  [293] = {.lex_state = 0},
-  [294] = {.lex_state = 0},
-  [295] = {.lex_state = 0},
-  [296] = {.lex_state = 1},
-  [297] = {.lex_state = 1},
-  [298] = {.lex_state = 0},
-  [299] = {.lex_state = 0},
-  [300] = {.lex_state = 0},
-  [301] = {.lex_state = 0},
-  [302] = {.lex_state = 0},
-  [303] = {.lex_state = 0},
-  [304] = {.lex_state = 0},
-  [305] = {.lex_state = 5},
-  [306] = {.lex_state = 0},
-  [307] = {.lex_state = 0},
-  [308] = {.lex_state = 0},
-  [309] = {.lex_state = 5},
-  [310] = {.lex_state = 0},
-  [311] = {.lex_state = 0},
-  [312] = {.lex_state = 1},
-  [313] = {.lex_state = 0},
-  [314] = {.lex_state = 0},
-  [315] = {.lex_state = 0},
-  [316] = {.lex_state = 0},
-  [317] = {.lex_state = 1},
-  [318] = {.lex_state = 5},
-  [319] = {.lex_state = 0},
-  [320] = {.lex_state = 1},
-  [321] = {.lex_state = 0},
-  [322] = {.lex_state = 0},
-  [323] = {.lex_state = 0},
-  [324] = {.lex_state = 0},
-  [325] = {.lex_state = 1},
-  [326] = {.lex_state = 1},
-  [327] = {.lex_state = 0},
-  [328] = {.lex_state = 5},
-  [329] = {.lex_state = 0},
-  [330] = {.lex_state = 1},
-  [331] = {.lex_state = 1},
-  [332] = {.lex_state = 0},
-  [333] = {.lex_state = 1},
-  [334] = {.lex_state = 5},
-  [335] = {.lex_state = 0},
-  [336] = {.lex_state = 0},
-  [337] = {.lex_state = 0},
-  [338] = {.lex_state = 0},
-  [339] = {.lex_state = 0},
-  [340] = {.lex_state = 0},
-  [341] = {.lex_state = 5},
-  [342] = {.lex_state = 0},
-  [343] = {.lex_state = 0},
-  [344] = {.lex_state = 0},
-  [345] = {.lex_state = 0},
-  [346] = {.lex_state = 0},
-  [347] = {.lex_state = 1},
-  [348] = {.lex_state = 0},
-  [349] = {.lex_state = 0},
-  [350] = {.lex_state = 0},
-  [351] = {.lex_state = 0},
-  [352] = {.lex_state = 0},
-  [353] = {.lex_state = 0},
-  [354] = {.lex_state = 0},
-  [355] = {.lex_state = 0},
-  [356] = {.lex_state = 0},
-  [357] = {.lex_state = 0},
-  [358] = {.lex_state = 0},
-  [359] = {.lex_state = 0},
-  [360] = {.lex_state = 0},
-  [361] = {.lex_state = 0},
-  [362] = {.lex_state = 0},
-  [363] = {.lex_state = 0},
-  [364] = {.lex_state = 0},
-  [365] = {.lex_state = 0},
-  [366] = {.lex_state = 0},
-  [367] = {.lex_state = 0},
-  [368] = {.lex_state = 0},
-  [369] = {.lex_state = 0},
-  [370] = {.lex_state = 0},
-  [371] = {.lex_state = 0},
-  [372] = {.lex_state = 3},
-  [373] = {.lex_state = 0},
-  [374] = {.lex_state = 0},
-  [375] = {.lex_state = 0},
-  [376] = {.lex_state = 0},
-  [377] = {.lex_state = 0},
-  [378] = {.lex_state = 0},
-  [379] = {.lex_state = 0},
-  [380] = {.lex_state = 0},
-  [381] = {.lex_state = 0},
-  [382] = {.lex_state = 0},
-  [383] = {.lex_state = 0},
-  [384] = {.lex_state = 0},
-  [385] = {.lex_state = 0},
-  [386] = {.lex_state = 0},
-  [387] = {.lex_state = 0},
-  [388] = {.lex_state = 0},
-  [389] = {.lex_state = 12},
-  [390] = {.lex_state = 0},
-  [391] = {.lex_state = 0},
-  [392] = {.lex_state = 0},
-  [393] = {.lex_state = 0},
-  [394] = {.lex_state = 0},
-  [395] = {.lex_state = 0},
-  [396] = {.lex_state = 0},
-  [397] = {.lex_state = 0},
-};
-
-static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
-  [0] = {
-    [ts_builtin_sym_end] = ACTIONS(1),
-    [sym_var] = ACTIONS(1),
-    [sym_int] = ACTIONS(1),
-    [anon_sym_BQUOTE] = ACTIONS(1),
-    [anon_sym_LBRACE] = ACTIONS(1),
-    [anon_sym_RBRACE] = ACTIONS(1),
-    [sym_cons_name] = ACTIONS(1),
-    [anon_sym_LPAREN] = ACTIONS(1),
-    [anon_sym_COMMA] = ACTIONS(1),
-    [anon_sym_RPAREN] = ACTIONS(1),
-    [anon_sym_BSLASH] = ACTIONS(1),
-    [anon_sym_DASH_GT] = ACTIONS(1),
-    [anon_sym_COLON] = ACTIONS(1),
-    [anon_sym_DOT] = ACTIONS(1),
-    [anon_sym_when] = ACTIONS(1),
-    [anon_sym_is] = ACTIONS(1),
+    case 65:
+      ACCEPT_TOKEN(anon_sym_SEMI);
+      END_STATE();
+    case 66:
+      ACCEPT_TOKEN(anon_sym_LT);
+      END_STATE();
+    case 67:
+      ACCEPT_TOKEN(anon_sym_EQ);
+      END_STATE();
+    case 68:
+      ACCEPT_TOKEN(anon_sym_GT);
+      END_STATE();
+    case 69:
+      ACCEPT_TOKEN(sym_cons_name);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(91);
+      END_STATE();
+    case 70:
+      ACCEPT_TOKEN(anon_sym_LBRACK);
+      END_STATE();
+    case 71:
+      ACCEPT_TOKEN(anon_sym_BSLASH);
+      END_STATE();
+    case 72:
+      ACCEPT_TOKEN(anon_sym_RBRACK);
+      END_STATE();
+    case 73:
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '_') ADVANCE(73);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      END_STATE();
+    case 74:
+      ACCEPT_TOKEN(anon_sym_BQUOTE);
+      END_STATE();
+    case 75:
+      ACCEPT_TOKEN(sym_var);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(92);
+      END_STATE();
+    case 76:
+      ACCEPT_TOKEN(anon_sym_LBRACE);
+      END_STATE();
+    case 77:
+      ACCEPT_TOKEN(anon_sym_RBRACE);
+      END_STATE();
+    case 78:
+      ACCEPT_TOKEN(sym_sym);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
+      END_STATE();
+    case 79:
+      ACCEPT_TOKEN(sym_sym);
+      if (lookahead == '-') ADVANCE(93);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
+      END_STATE();
+    case 80:
+      ACCEPT_TOKEN(anon_sym_EQ);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
+      END_STATE();
+    case 81:
+      if (lookahead == '_') ADVANCE(81);
+      if (('a' <= lookahead && lookahead <= 'z')) ADVANCE(75);
+      END_STATE();
+    case 82:
+      ACCEPT_TOKEN(sym_lit_str);
+      if (lookahead == '\\') ADVANCE(84);
+      if ((0x1 <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(82);
+      END_STATE();
+    case 83:
+      ACCEPT_TOKEN(sym_lit_str);
+      if (lookahead == '\n') ADVANCE(82);
+      if (lookahead == '\\') ADVANCE(95);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(94);
+      if (lookahead == '`' ||
+          lookahead == '{' ||
+          lookahead == '}') ADVANCE(89);
+      END_STATE();
+    case 84:
+      if (lookahead == 'u') ADVANCE(97);
+      if ((0x1 <= lookahead && lookahead <= 't') ||
+          ('v' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(96);
+      END_STATE();
+    case 85:
+      ACCEPT_TOKEN(anon_sym_LF);
+      END_STATE();
+    case 86:
+      if (('A' <= lookahead && lookahead <= 'Z')) ADVANCE(69);
+      if (lookahead == '_') ADVANCE(86);
+      END_STATE();
+    case 87:
+      ACCEPT_TOKEN(sym_fmt_spec);
+      if (lookahead == '-' ||
+          lookahead == '.' ||
+          ('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'Z') ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(87);
+      END_STATE();
+    case 88:
+      if (lookahead == ' ') ADVANCE(98);
+      END_STATE();
+    case 89:
+      ACCEPT_TOKEN(sym__comment);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(89);
+      END_STATE();
+    case 90:
+      ACCEPT_TOKEN(anon_sym_DASH_GT);
+      END_STATE();
+    case 91:
+      ACCEPT_TOKEN(sym_cons_name);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(91);
+      END_STATE();
+    case 92:
+      ACCEPT_TOKEN(sym_var);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'Z') ||
+          lookahead == '_' ||
+          ('a' <= lookahead && lookahead <= 'z')) ADVANCE(92);
+      END_STATE();
+    case 93:
+      ACCEPT_TOKEN(anon_sym_LT_DASH);
+      if (lookahead == '!' ||
+          ('$' <= lookahead && lookahead <= '&') ||
+          lookahead == '*' ||
+          lookahead == '+' ||
+          lookahead == '-' ||
+          ('<' <= lookahead && lookahead <= '>') ||
+          lookahead == '@' ||
+          lookahead == '^' ||
+          lookahead == '~') ADVANCE(78);
+      END_STATE();
+    case 94:
+      ACCEPT_TOKEN(sym_lit_str);
+      if (lookahead == '\n') ADVANCE(82);
+      if (lookahead == '\\') ADVANCE(95);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(94);
+      if (lookahead == '`' ||
+          lookahead == '{' ||
+          lookahead == '}') ADVANCE(89);
+      END_STATE();
+    case 95:
+      ACCEPT_TOKEN(sym__comment);
+      if (lookahead == '\n') ADVANCE(96);
+      if (lookahead == 'u') ADVANCE(100);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= 't') ||
+          ('v' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(99);
+      END_STATE();
+    case 96:
+      ACCEPT_TOKEN(sym_lit_str);
+      if (lookahead == '\\') ADVANCE(84);
+      if ((0x1 <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(82);
+      END_STATE();
+    case 97:
+      if (lookahead == '{') ADVANCE(101);
+      END_STATE();
+    case 98:
+      ACCEPT_TOKEN(anon_sym_COMMA2);
+      END_STATE();
+    case 99:
+      ACCEPT_TOKEN(sym_lit_str);
+      if (lookahead == '\n') ADVANCE(82);
+      if (lookahead == '\\') ADVANCE(95);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(94);
+      if (lookahead == '`' ||
+          lookahead == '{' ||
+          lookahead == '}') ADVANCE(89);
+      END_STATE();
+    case 100:
+      ACCEPT_TOKEN(sym__comment);
+      if (lookahead == '{') ADVANCE(102);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= 'z') ||
+          ('|' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(89);
+      END_STATE();
+    case 101:
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(103);
+      END_STATE();
+    case 102:
+      ACCEPT_TOKEN(sym__comment);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= '/') ||
+          (':' <= lookahead && lookahead <= '@') ||
+          ('G' <= lookahead && lookahead <= '`') ||
+          ('g' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(89);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(104);
+      END_STATE();
+    case 103:
+      if (lookahead == '}') ADVANCE(105);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(103);
+      END_STATE();
+    case 104:
+      ACCEPT_TOKEN(sym__comment);
+      if (lookahead == '}') ADVANCE(106);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= '/') ||
+          (':' <= lookahead && lookahead <= '@') ||
+          ('G' <= lookahead && lookahead <= '`') ||
+          ('g' <= lookahead && lookahead <= '|') ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(89);
+      if (('0' <= lookahead && lookahead <= '9') ||
+          ('A' <= lookahead && lookahead <= 'F') ||
+          ('a' <= lookahead && lookahead <= 'f')) ADVANCE(104);
+      END_STATE();
+    case 105:
+      ACCEPT_TOKEN(sym_lit_str);
+      if (lookahead == '\\') ADVANCE(84);
+      if ((0x1 <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(82);
+      END_STATE();
+    case 106:
+      ACCEPT_TOKEN(sym_lit_str);
+      if (lookahead == '\n') ADVANCE(82);
+      if (lookahead == '\\') ADVANCE(95);
+      if ((0x1 <= lookahead && lookahead <= '\t') ||
+          ('\v' <= lookahead && lookahead <= '[') ||
+          (']' <= lookahead && lookahead <= '_') ||
+          ('a' <= lookahead && lookahead <= 'z') ||
+          lookahead == '|' ||
+          ('~' <= lookahead && lookahead <= 0x10ffff)) ADVANCE(94);
+      if (lookahead == '`' ||
+          lookahead == '{' ||
+          lookahead == '}') ADVANCE(89);
+      END_STATE();
+    default:
+      return false;
+  }
+}
+
+static bool ts_lex_keywords(TSLexer *lexer, TSStateId state) {
+  START_LEXER();
+  eof = lexer->eof(lexer);
+  switch (state) {
+    case 0:
+      if (lookahead == 'e') ADVANCE(1);
+      if (lookahead == 'f') ADVANCE(2);
+      if (lookahead == 'i') ADVANCE(3);
+      if (lookahead == 'w') ADVANCE(4);
+      if (('\t' <= lookahead && lookahead <= '\r') ||
+          lookahead == ' ' ||
+          lookahead == 0x200b ||
+          lookahead == 0x2060 ||
+          lookahead == 0xfeff) SKIP(0);
+      END_STATE();
+    case 1:
+      if (lookahead == 'l') ADVANCE(5);
+      END_STATE();
+    case 2:
+      if (lookahead == 'r') ADVANCE(6);
+      END_STATE();
+    case 3:
+      if (lookahead == 'm') ADVANCE(7);
+      if (lookahead == 's') ADVANCE(8);
+      END_STATE();
+    case 4:
+      if (lookahead == 'h') ADVANCE(9);
+      if (lookahead == 'i') ADVANCE(10);
+      END_STATE();
+    case 5:
+      if (lookahead == 's') ADVANCE(11);
+      END_STATE();
+    case 6:
+      if (lookahead == 'o') ADVANCE(12);
+      END_STATE();
+    case 7:
+      if (lookahead == 'p') ADVANCE(13);
+      END_STATE();
+    case 8:
+      ACCEPT_TOKEN(anon_sym_is);
+      END_STATE();
+    case 9:
+      if (lookahead == 'e') ADVANCE(14);
+      END_STATE();
+    case 10:
+      if (lookahead == 't') ADVANCE(15);
+      END_STATE();
+    case 11:
+      if (lookahead == 'e') ADVANCE(16);
+      END_STATE();
+    case 12:
+      if (lookahead == 'm') ADVANCE(17);
+      END_STATE();
+    case 13:
+      if (lookahead == 'o') ADVANCE(18);
+      END_STATE();
+    case 14:
+      if (lookahead == 'n') ADVANCE(19);
+      END_STATE();
+    case 15:
+      if (lookahead == 'h') ADVANCE(20);
+      END_STATE();
+    case 16:
+      ACCEPT_TOKEN(anon_sym_else);
+      END_STATE();
+    case 17:
+      ACCEPT_TOKEN(anon_sym_from);
+      END_STATE();
+    case 18:
+      if (lookahead == 'r') ADVANCE(21);
+      END_STATE();
+    case 19:
+      ACCEPT_TOKEN(anon_sym_when);
+      END_STATE();
+    case 20:
+      ACCEPT_TOKEN(anon_sym_with);
+      END_STATE();
+    case 21:
+      if (lookahead == 't') ADVANCE(22);
+      END_STATE();
+    case 22:
+      ACCEPT_TOKEN(anon_sym_import);
+      END_STATE();
+    default:
+      return false;
+  }
+}
+
+static const TSLexMode ts_lex_modes[STATE_COUNT] = {
+  [0] = {.lex_state = 0},
+  [1] = {.lex_state = 1},
+  [2] = {.lex_state = 2},
+  [3] = {.lex_state = 3},
+  [4] = {.lex_state = 4},
+  [5] = {.lex_state = 5},
+  [6] = {.lex_state = 6},
+  [7] = {.lex_state = 3},
+  [8] = {.lex_state = 1},
+  [9] = {.lex_state = 7},
+  [10] = {.lex_state = 1},
+  [11] = {.lex_state = 8},
+  [12] = {.lex_state = 9},
+  [13] = {.lex_state = 9},
+  [14] = {.lex_state = 10},
+  [15] = {.lex_state = 11},
+  [16] = {.lex_state = 3},
+  [17] = {.lex_state = 3},
+  [18] = {.lex_state = 3},
+  [19] = {.lex_state = 3},
+  [20] = {.lex_state = 3},
+  [21] = {.lex_state = 3},
+  [22] = {.lex_state = 3},
+  [23] = {.lex_state = 3},
+  [24] = {.lex_state = 3},
+  [25] = {.lex_state = 12},
+  [26] = {.lex_state = 13},
+  [27] = {.lex_state = 13},
+  [28] = {.lex_state = 13},
+  [29] = {.lex_state = 13},
+  [30] = {.lex_state = 13},
+  [31] = {.lex_state = 10},
+  [32] = {.lex_state = 3},
+  [33] = {.lex_state = 3},
+  [34] = {.lex_state = 1},
+  [35] = {.lex_state = 14},
+  [36] = {.lex_state = 1},
+  [37] = {.lex_state = 1},
+  [38] = {.lex_state = 4},
+  [39] = {.lex_state = 3},
+  [40] = {.lex_state = 1},
+  [41] = {.lex_state = 4},
+  [42] = {.lex_state = 15},
+  [43] = {.lex_state = 3},
+  [44] = {.lex_state = 9},
+  [45] = {.lex_state = 3},
+  [46] = {.lex_state = 3},
+  [47] = {.lex_state = 3},
+  [48] = {.lex_state = 16},
+  [49] = {.lex_state = 17},
+  [50] = {.lex_state = 18},
+  [51] = {.lex_state = 1},
+  [52] = {.lex_state = 9},
+  [53] = {.lex_state = 19},
+  [54] = {.lex_state = 1},
+  [55] = {.lex_state = 7},
+  [56] = {.lex_state = 1},
+  [57] = {.lex_state = 20},
+  [58] = {.lex_state = 3},
+  [59] = {.lex_state = 21},
+  [60] = {.lex_state = 1},
+  [61] = {.lex_state = 9},
+  [62] = {.lex_state = 22},
+  [63] = {.lex_state = 1},
+  [64] = {.lex_state = 23},
+  [65] = {.lex_state = 9},
+  [66] = {.lex_state = 1},
+  [67] = {.lex_state = 1},
+  [68] = {.lex_state = 1},
+  [69] = {.lex_state = 1},
+  [70] = {.lex_state = 11},
+  [71] = {.lex_state = 13},
+  [72] = {.lex_state = 13},
+  [73] = {.lex_state = 5},
+  [74] = {.lex_state = 24},
+  [75] = {.lex_state = 14},
+  [76] = {.lex_state = 25},
+  [77] = {.lex_state = 13},
+  [78] = {.lex_state = 13},
+  [79] = {.lex_state = 13},
+  [80] = {.lex_state = 13},
+  [81] = {.lex_state = 13},
+  [82] = {.lex_state = 26},
+  [83] = {.lex_state = 26},
+  [84] = {.lex_state = 4},
+  [85] = {.lex_state = 5},
+  [86] = {.lex_state = 27},
+  [87] = {.lex_state = 26},
+  [88] = {.lex_state = 1},
+  [89] = {.lex_state = 7},
+  [90] = {.lex_state = 1},
+  [91] = {.lex_state = 8},
+  [92] = {.lex_state = 9},
+  [93] = {.lex_state = 26},
+  [94] = {.lex_state = 26},
+  [95] = {.lex_state = 26},
+  [96] = {.lex_state = 26},
+  [97] = {.lex_state = 26},
+  [98] = {.lex_state = 26},
+  [99] = {.lex_state = 26},
+  [100] = {.lex_state = 26},
+  [101] = {.lex_state = 26},
+  [102] = {.lex_state = 26},
+  [103] = {.lex_state = 26},
+  [104] = {.lex_state = 26},
+  [105] = {.lex_state = 26},
+  [106] = {.lex_state = 26},
+  [107] = {.lex_state = 28},
+  [108] = {.lex_state = 4},
+  [109] = {.lex_state = 3},
+  [110] = {.lex_state = 1},
+  [111] = {.lex_state = 1},
+  [112] = {.lex_state = 15},
+  [113] = {.lex_state = 1},
+  [114] = {.lex_state = 29},
+  [115] = {.lex_state = 3},
+  [116] = {.lex_state = 7},
+  [117] = {.lex_state = 1},
+  [118] = {.lex_state = 30},
+  [119] = {.lex_state = 18},
+  [120] = {.lex_state = 16},
+  [121] = {.lex_state = 18},
+  [122] = {.lex_state = 1},
+  [123] = {.lex_state = 9},
+  [124] = {.lex_state = 20},
+  [125] = {.lex_state = 1},
+  [126] = {.lex_state = 31},
+  [127] = {.lex_state = 8},
+  [128] = {.lex_state = 3},
+  [129] = {.lex_state = 21},
+  [130] = {.lex_state = 32},
+  [131] = {.lex_state = 1},
+  [132] = {.lex_state = 9},
+  [133] = {.lex_state = 3},
+  [134] = {.lex_state = 22},
+  [135] = {.lex_state = 3},
+  [136] = {.lex_state = 3},
+  [137] = {.lex_state = 16},
+  [138] = {.lex_state = 1},
+  [139] = {.lex_state = 3},
+  [140] = {.lex_state = 26},
+  [141] = {.lex_state = 26},
+  [142] = {.lex_state = 1},
+  [143] = {.lex_state = 1},
+  [144] = {.lex_state = 15},
+  [145] = {.lex_state = 13},
+  [146] = {.lex_state = 9},
+  [147] = {.lex_state = 33},
+  [148] = {.lex_state = 34},
+  [149] = {.lex_state = 5},
+  [150] = {.lex_state = 35},
+  [151] = {.lex_state = 14},
+  [152] = {.lex_state = 25},
+  [153] = {.lex_state = 36},
+  [154] = {.lex_state = 34},
+  [155] = {.lex_state = 34},
+  [156] = {.lex_state = 34},
+  [157] = {.lex_state = 34},
+  [158] = {.lex_state = 14},
+  [159] = {.lex_state = 13},
+  [160] = {.lex_state = 31},
+  [161] = {.lex_state = 26},
+  [162] = {.lex_state = 4},
+  [163] = {.lex_state = 15},
+  [164] = {.lex_state = 26},
+  [165] = {.lex_state = 9},
+  [166] = {.lex_state = 26},
+  [167] = {.lex_state = 16},
+  [168] = {.lex_state = 17},
+  [169] = {.lex_state = 18},
+  [170] = {.lex_state = 1},
+  [171] = {.lex_state = 9},
+  [172] = {.lex_state = 20},
+  [173] = {.lex_state = 26},
+  [174] = {.lex_state = 21},
+  [175] = {.lex_state = 1},
+  [176] = {.lex_state = 22},
+  [177] = {.lex_state = 1},
+  [178] = {.lex_state = 23},
+  [179] = {.lex_state = 9},
+  [180] = {.lex_state = 37},
+  [181] = {.lex_state = 4},
+  [182] = {.lex_state = 28},
+  [183] = {.lex_state = 38},
+  [184] = {.lex_state = 1},
+  [185] = {.lex_state = 16},
+  [186] = {.lex_state = 23},
+  [187] = {.lex_state = 12},
+  [188] = {.lex_state = 1},
+  [189] = {.lex_state = 30},
+  [190] = {.lex_state = 7},
+  [191] = {.lex_state = 1},
+  [192] = {.lex_state = 29},
+  [193] = {.lex_state = 7},
+  [194] = {.lex_state = 1},
+  [195] = {.lex_state = 20},
+  [196] = {.lex_state = 18},
+  [197] = {.lex_state = 31},
+  [198] = {.lex_state = 39},
+  [199] = {.lex_state = 31},
+  [200] = {.lex_state = 3},
+  [201] = {.lex_state = 8},
+  [202] = {.lex_state = 3},
+  [203] = {.lex_state = 40},
+  [204] = {.lex_state = 22},
+  [205] = {.lex_state = 9},
+  [206] = {.lex_state = 3},
+  [207] = {.lex_state = 23},
+  [208] = {.lex_state = 3},
+  [209] = {.lex_state = 16},
+  [210] = {.lex_state = 14},
+  [211] = {.lex_state = 15},
+  [212] = {.lex_state = 41},
+  [213] = {.lex_state = 5},
+  [214] = {.lex_state = 42},
+  [215] = {.lex_state = 14},
+  [216] = {.lex_state = 25},
+  [217] = {.lex_state = 13},
+  [218] = {.lex_state = 41},
+  [219] = {.lex_state = 41},
+  [220] = {.lex_state = 41},
+  [221] = {.lex_state = 41},
+  [222] = {.lex_state = 41},
+  [223] = {.lex_state = 14},
+  [224] = {.lex_state = 15},
+  [225] = {.lex_state = 34},
+  [226] = {.lex_state = 9},
+  [227] = {.lex_state = 33},
+  [228] = {.lex_state = 36},
+  [229] = {.lex_state = 14},
+  [230] = {.lex_state = 34},
+  [231] = {.lex_state = 31},
+  [232] = {.lex_state = 14},
+  [233] = {.lex_state = 29},
+  [234] = {.lex_state = 43},
+  [235] = {.lex_state = 14},
+  [236] = {.lex_state = 26},
+  [237] = {.lex_state = 1},
+  [238] = {.lex_state = 15},
+  [239] = {.lex_state = 29},
+  [240] = {.lex_state = 26},
+  [241] = {.lex_state = 7},
+  [242] = {.lex_state = 1},
+  [243] = {.lex_state = 26},
+  [244] = {.lex_state = 18},
+  [245] = {.lex_state = 31},
+  [246] = {.lex_state = 8},
+  [247] = {.lex_state = 26},
+  [248] = {.lex_state = 21},
+  [249] = {.lex_state = 1},
+  [250] = {.lex_state = 26},
+  [251] = {.lex_state = 22},
+  [252] = {.lex_state = 26},
+  [253] = {.lex_state = 26},
+  [254] = {.lex_state = 16},
+  [255] = {.lex_state = 1},
+  [256] = {.lex_state = 26},
+  [257] = {.lex_state = 44},
+  [258] = {.lex_state = 37},
+  [259] = {.lex_state = 4},
+  [260] = {.lex_state = 3},
+  [261] = {.lex_state = 5},
+  [262] = {.lex_state = 38},
+  [263] = {.lex_state = 12},
+  [264] = {.lex_state = 16},
+  [265] = {.lex_state = 30},
+  [266] = {.lex_state = 1},
+  [267] = {.lex_state = 30},
+  [268] = {.lex_state = 23},
+  [269] = {.lex_state = 3},
+  [270] = {.lex_state = 1},
+  [271] = {.lex_state = 20},
+  [272] = {.lex_state = 7},
+  [273] = {.lex_state = 1},
+  [274] = {.lex_state = 39},
+  [275] = {.lex_state = 31},
+  [276] = {.lex_state = 45},
+  [277] = {.lex_state = 1},
+  [278] = {.lex_state = 45},
+  [279] = {.lex_state = 39},
+  [280] = {.lex_state = 3},
+  [281] = {.lex_state = 32},
+  [282] = {.lex_state = 22},
+  [283] = {.lex_state = 3},
+  [284] = {.lex_state = 23},
+  [285] = {.lex_state = 3},
+  [286] = {.lex_state = 46},
+  [287] = {.lex_state = 14},
+  [288] = {.lex_state = 15},
+  [289] = {.lex_state = 41},
+  [290] = {.lex_state = 9},
+  [291] = {.lex_state = 33},
+  [292] = {.lex_state = 36},
+  [293] = {.lex_state = 14},
+  [294] = {.lex_state = 41},
+  [295] = {.lex_state = 31},
+  [296] = {.lex_state = 33},
+  [297] = {.lex_state = 13},
+  [298] = {.lex_state = 41},
+  [299] = {.lex_state = 14},
+  [300] = {.lex_state = 15},
+  [301] = {.lex_state = 34},
+  [302] = {.lex_state = 41},
+  [303] = {.lex_state = 14},
+  [304] = {.lex_state = 29},
+  [305] = {.lex_state = 43},
+  [306] = {.lex_state = 14},
+  [307] = {.lex_state = 29},
+  [308] = {.lex_state = 14},
+  [309] = {.lex_state = 13},
+  [310] = {.lex_state = 25},
+  [311] = {.lex_state = 13},
+  [312] = {.lex_state = 43},
+  [313] = {.lex_state = 38},
+  [314] = {.lex_state = 1},
+  [315] = {.lex_state = 23},
+  [316] = {.lex_state = 26},
+  [317] = {.lex_state = 1},
+  [318] = {.lex_state = 26},
+  [319] = {.lex_state = 7},
+  [320] = {.lex_state = 1},
+  [321] = {.lex_state = 39},
+  [322] = {.lex_state = 31},
+  [323] = {.lex_state = 26},
+  [324] = {.lex_state = 8},
+  [325] = {.lex_state = 26},
+  [326] = {.lex_state = 26},
+  [327] = {.lex_state = 23},
+  [328] = {.lex_state = 26},
+  [329] = {.lex_state = 16},
+  [330] = {.lex_state = 4},
+  [331] = {.lex_state = 44},
+  [332] = {.lex_state = 3},
+  [333] = {.lex_state = 3},
+  [334] = {.lex_state = 5},
+  [335] = {.lex_state = 30},
+  [336] = {.lex_state = 3},
+  [337] = {.lex_state = 20},
+  [338] = {.lex_state = 1},
+  [339] = {.lex_state = 20},
+  [340] = {.lex_state = 45},
+  [341] = {.lex_state = 45},
+  [342] = {.lex_state = 39},
+  [343] = {.lex_state = 1},
+  [344] = {.lex_state = 47},
+  [345] = {.lex_state = 1},
+  [346] = {.lex_state = 45},
+  [347] = {.lex_state = 45},
+  [348] = {.lex_state = 13},
+  [349] = {.lex_state = 3},
+  [350] = {.lex_state = 13},
+  [351] = {.lex_state = 5},
+  [352] = {.lex_state = 46},
+  [353] = {.lex_state = 14},
+  [354] = {.lex_state = 15},
+  [355] = {.lex_state = 41},
+  [356] = {.lex_state = 41},
+  [357] = {.lex_state = 14},
+  [358] = {.lex_state = 29},
+  [359] = {.lex_state = 43},
+  [360] = {.lex_state = 14},
+  [361] = {.lex_state = 13},
+  [362] = {.lex_state = 33},
+  [363] = {.lex_state = 13},
+  [364] = {.lex_state = 46},
+  [365] = {.lex_state = 14},
+  [366] = {.lex_state = 33},
+  [367] = {.lex_state = 34},
+  [368] = {.lex_state = 41},
+  [369] = {.lex_state = 34},
+  [370] = {.lex_state = 25},
+  [371] = {.lex_state = 34},
+  [372] = {.lex_state = 43},
+  [373] = {.lex_state = 29},
+  [374] = {.lex_state = 13},
+  [375] = {.lex_state = 25},
+  [376] = {.lex_state = 13},
+  [377] = {.lex_state = 26},
+  [378] = {.lex_state = 5},
+  [379] = {.lex_state = 38},
+  [380] = {.lex_state = 26},
+  [381] = {.lex_state = 26},
+  [382] = {.lex_state = 1},
+  [383] = {.lex_state = 26},
+  [384] = {.lex_state = 45},
+  [385] = {.lex_state = 45},
+  [386] = {.lex_state = 39},
+  [387] = {.lex_state = 26},
+  [388] = {.lex_state = 26},
+  [389] = {.lex_state = 23},
+  [390] = {.lex_state = 26},
+  [391] = {.lex_state = 4},
+  [392] = {.lex_state = 3},
+  [393] = {.lex_state = 20},
+  [394] = {.lex_state = 1},
+  [395] = {.lex_state = 1},
+  [396] = {.lex_state = 45},
+  [397] = {.lex_state = 45},
+  [398] = {.lex_state = 48},
+  [399] = {.lex_state = 7},
+  [400] = {.lex_state = 1},
+  [401] = {.lex_state = 3},
+  [402] = {.lex_state = 29},
+  [403] = {.lex_state = 3},
+  [404] = {.lex_state = 1},
+  [405] = {.lex_state = 1},
+  [406] = {.lex_state = 13},
+  [407] = {.lex_state = 13},
+  [408] = {.lex_state = 5},
+  [409] = {.lex_state = 46},
+  [410] = {.lex_state = 14},
+  [411] = {.lex_state = 33},
+  [412] = {.lex_state = 41},
+  [413] = {.lex_state = 41},
+  [414] = {.lex_state = 41},
+  [415] = {.lex_state = 25},
+  [416] = {.lex_state = 41},
+  [417] = {.lex_state = 43},
+  [418] = {.lex_state = 13},
+  [419] = {.lex_state = 34},
+  [420] = {.lex_state = 5},
+  [421] = {.lex_state = 46},
+  [422] = {.lex_state = 34},
+  [423] = {.lex_state = 33},
+  [424] = {.lex_state = 34},
+  [425] = {.lex_state = 34},
+  [426] = {.lex_state = 25},
+  [427] = {.lex_state = 34},
+  [428] = {.lex_state = 13},
+  [429] = {.lex_state = 26},
+  [430] = {.lex_state = 26},
+  [431] = {.lex_state = 5},
+  [432] = {.lex_state = 26},
+  [433] = {.lex_state = 1},
+  [434] = {.lex_state = 1},
+  [435] = {.lex_state = 45},
+  [436] = {.lex_state = 45},
+  [437] = {.lex_state = 26},
+  [438] = {.lex_state = 49},
+  [439] = {.lex_state = 7},
+  [440] = {.lex_state = 1},
+  [441] = {.lex_state = 50},
+  [442] = {.lex_state = 50},
+  [443] = {.lex_state = 1},
+  [444] = {.lex_state = 1},
+  [445] = {.lex_state = 18},
+  [446] = {.lex_state = 1},
+  [447] = {.lex_state = 9},
+  [448] = {.lex_state = 20},
+  [449] = {.lex_state = 1},
+  [450] = {.lex_state = 51},
+  [451] = {.lex_state = 1},
+  [452] = {.lex_state = 23},
+  [453] = {.lex_state = 45},
+  [454] = {.lex_state = 51},
+  [455] = {.lex_state = 1},
+  [456] = {.lex_state = 3},
+  [457] = {.lex_state = 3},
+  [458] = {.lex_state = 13},
+  [459] = {.lex_state = 41},
+  [460] = {.lex_state = 5},
+  [461] = {.lex_state = 46},
+  [462] = {.lex_state = 41},
+  [463] = {.lex_state = 33},
+  [464] = {.lex_state = 41},
+  [465] = {.lex_state = 41},
+  [466] = {.lex_state = 25},
+  [467] = {.lex_state = 41},
+  [468] = {.lex_state = 34},
+  [469] = {.lex_state = 34},
+  [470] = {.lex_state = 5},
+  [471] = {.lex_state = 34},
+  [472] = {.lex_state = 34},
+  [473] = {.lex_state = 26},
+  [474] = {.lex_state = 52},
+  [475] = {.lex_state = 52},
+  [476] = {.lex_state = 4},
+  [477] = {.lex_state = 5},
+  [478] = {.lex_state = 53},
+  [479] = {.lex_state = 52},
+  [480] = {.lex_state = 1},
+  [481] = {.lex_state = 7},
+  [482] = {.lex_state = 1},
+  [483] = {.lex_state = 8},
+  [484] = {.lex_state = 9},
+  [485] = {.lex_state = 52},
+  [486] = {.lex_state = 52},
+  [487] = {.lex_state = 52},
+  [488] = {.lex_state = 52},
+  [489] = {.lex_state = 52},
+  [490] = {.lex_state = 52},
+  [491] = {.lex_state = 52},
+  [492] = {.lex_state = 52},
+  [493] = {.lex_state = 52},
+  [494] = {.lex_state = 52},
+  [495] = {.lex_state = 52},
+  [496] = {.lex_state = 52},
+  [497] = {.lex_state = 52},
+  [498] = {.lex_state = 52},
+  [499] = {.lex_state = 1},
+  [500] = {.lex_state = 1},
+  [501] = {.lex_state = 18},
+  [502] = {.lex_state = 1},
+  [503] = {.lex_state = 9},
+  [504] = {.lex_state = 20},
+  [505] = {.lex_state = 1},
+  [506] = {.lex_state = 54},
+  [507] = {.lex_state = 1},
+  [508] = {.lex_state = 54},
+  [509] = {.lex_state = 1},
+  [510] = {.lex_state = 50},
+  [511] = {.lex_state = 50},
+  [512] = {.lex_state = 7},
+  [513] = {.lex_state = 1},
+  [514] = {.lex_state = 3},
+  [515] = {.lex_state = 18},
+  [516] = {.lex_state = 31},
+  [517] = {.lex_state = 1},
+  [518] = {.lex_state = 30},
+  [519] = {.lex_state = 45},
+  [520] = {.lex_state = 1},
+  [521] = {.lex_state = 30},
+  [522] = {.lex_state = 51},
+  [523] = {.lex_state = 1},
+  [524] = {.lex_state = 51},
+  [525] = {.lex_state = 1},
+  [526] = {.lex_state = 41},
+  [527] = {.lex_state = 41},
+  [528] = {.lex_state = 5},
+  [529] = {.lex_state = 41},
+  [530] = {.lex_state = 41},
+  [531] = {.lex_state = 34},
+  [532] = {.lex_state = 52},
+  [533] = {.lex_state = 4},
+  [534] = {.lex_state = 15},
+  [535] = {.lex_state = 52},
+  [536] = {.lex_state = 9},
+  [537] = {.lex_state = 52},
+  [538] = {.lex_state = 16},
+  [539] = {.lex_state = 17},
+  [540] = {.lex_state = 18},
+  [541] = {.lex_state = 1},
+  [542] = {.lex_state = 9},
+  [543] = {.lex_state = 20},
+  [544] = {.lex_state = 52},
+  [545] = {.lex_state = 21},
+  [546] = {.lex_state = 1},
+  [547] = {.lex_state = 22},
+  [548] = {.lex_state = 1},
+  [549] = {.lex_state = 23},
+  [550] = {.lex_state = 9},
+  [551] = {.lex_state = 55},
+  [552] = {.lex_state = 1},
+  [553] = {.lex_state = 55},
+  [554] = {.lex_state = 1},
+  [555] = {.lex_state = 52},
+  [556] = {.lex_state = 52},
+  [557] = {.lex_state = 7},
+  [558] = {.lex_state = 1},
+  [559] = {.lex_state = 50},
+  [560] = {.lex_state = 18},
+  [561] = {.lex_state = 31},
+  [562] = {.lex_state = 1},
+  [563] = {.lex_state = 20},
+  [564] = {.lex_state = 1},
+  [565] = {.lex_state = 20},
+  [566] = {.lex_state = 54},
+  [567] = {.lex_state = 1},
+  [568] = {.lex_state = 54},
+  [569] = {.lex_state = 1},
+  [570] = {.lex_state = 1},
+  [571] = {.lex_state = 3},
+  [572] = {.lex_state = 7},
+  [573] = {.lex_state = 1},
+  [574] = {.lex_state = 39},
+  [575] = {.lex_state = 31},
+  [576] = {.lex_state = 30},
+  [577] = {.lex_state = 30},
+  [578] = {.lex_state = 1},
+  [579] = {.lex_state = 30},
+  [580] = {.lex_state = 1},
+  [581] = {.lex_state = 30},
+  [582] = {.lex_state = 41},
+  [583] = {.lex_state = 52},
+  [584] = {.lex_state = 1},
+  [585] = {.lex_state = 15},
+  [586] = {.lex_state = 29},
+  [587] = {.lex_state = 52},
+  [588] = {.lex_state = 7},
+  [589] = {.lex_state = 1},
+  [590] = {.lex_state = 52},
+  [591] = {.lex_state = 18},
+  [592] = {.lex_state = 31},
+  [593] = {.lex_state = 8},
+  [594] = {.lex_state = 52},
+  [595] = {.lex_state = 21},
+  [596] = {.lex_state = 1},
+  [597] = {.lex_state = 52},
+  [598] = {.lex_state = 22},
+  [599] = {.lex_state = 52},
+  [600] = {.lex_state = 52},
+  [601] = {.lex_state = 16},
+  [602] = {.lex_state = 1},
+  [603] = {.lex_state = 52},
+  [604] = {.lex_state = 1},
+  [605] = {.lex_state = 26},
+  [606] = {.lex_state = 1},
+  [607] = {.lex_state = 26},
+  [608] = {.lex_state = 55},
+  [609] = {.lex_state = 1},
+  [610] = {.lex_state = 55},
+  [611] = {.lex_state = 1},
+  [612] = {.lex_state = 1},
+  [613] = {.lex_state = 50},
+  [614] = {.lex_state = 7},
+  [615] = {.lex_state = 1},
+  [616] = {.lex_state = 39},
+  [617] = {.lex_state = 31},
+  [618] = {.lex_state = 20},
+  [619] = {.lex_state = 20},
+  [620] = {.lex_state = 1},
+  [621] = {.lex_state = 20},
+  [622] = {.lex_state = 1},
+  [623] = {.lex_state = 20},
+  [624] = {.lex_state = 3},
+  [625] = {.lex_state = 1},
+  [626] = {.lex_state = 3},
+  [627] = {.lex_state = 45},
+  [628] = {.lex_state = 45},
+  [629] = {.lex_state = 39},
+  [630] = {.lex_state = 30},
+  [631] = {.lex_state = 30},
+  [632] = {.lex_state = 38},
+  [633] = {.lex_state = 1},
+  [634] = {.lex_state = 23},
+  [635] = {.lex_state = 52},
+  [636] = {.lex_state = 1},
+  [637] = {.lex_state = 52},
+  [638] = {.lex_state = 7},
+  [639] = {.lex_state = 1},
+  [640] = {.lex_state = 39},
+  [641] = {.lex_state = 31},
+  [642] = {.lex_state = 52},
+  [643] = {.lex_state = 8},
+  [644] = {.lex_state = 52},
+  [645] = {.lex_state = 52},
+  [646] = {.lex_state = 23},
+  [647] = {.lex_state = 52},
+  [648] = {.lex_state = 16},
+  [649] = {.lex_state = 26},
+  [650] = {.lex_state = 26},
+  [651] = {.lex_state = 1},
+  [652] = {.lex_state = 26},
+  [653] = {.lex_state = 1},
+  [654] = {.lex_state = 26},
+  [655] = {.lex_state = 50},
+  [656] = {.lex_state = 1},
+  [657] = {.lex_state = 50},
+  [658] = {.lex_state = 45},
+  [659] = {.lex_state = 45},
+  [660] = {.lex_state = 39},
+  [661] = {.lex_state = 20},
+  [662] = {.lex_state = 20},
+  [663] = {.lex_state = 3},
+  [664] = {.lex_state = 1},
+  [665] = {.lex_state = 1},
+  [666] = {.lex_state = 45},
+  [667] = {.lex_state = 45},
+  [668] = {.lex_state = 52},
+  [669] = {.lex_state = 5},
+  [670] = {.lex_state = 38},
+  [671] = {.lex_state = 52},
+  [672] = {.lex_state = 52},
+  [673] = {.lex_state = 1},
+  [674] = {.lex_state = 52},
+  [675] = {.lex_state = 45},
+  [676] = {.lex_state = 45},
+  [677] = {.lex_state = 39},
+  [678] = {.lex_state = 52},
+  [679] = {.lex_state = 52},
+  [680] = {.lex_state = 23},
+  [681] = {.lex_state = 52},
+  [682] = {.lex_state = 26},
+  [683] = {.lex_state = 26},
+  [684] = {.lex_state = 50},
+  [685] = {.lex_state = 1},
+  [686] = {.lex_state = 1},
+  [687] = {.lex_state = 45},
+  [688] = {.lex_state = 45},
+  [689] = {.lex_state = 3},
+  [690] = {.lex_state = 3},
+  [691] = {.lex_state = 1},
+  [692] = {.lex_state = 1},
+  [693] = {.lex_state = 52},
+  [694] = {.lex_state = 52},
+  [695] = {.lex_state = 5},
+  [696] = {.lex_state = 52},
+  [697] = {.lex_state = 1},
+  [698] = {.lex_state = 1},
+  [699] = {.lex_state = 45},
+  [700] = {.lex_state = 45},
+  [701] = {.lex_state = 52},
+  [702] = {.lex_state = 50},
+  [703] = {.lex_state = 50},
+  [704] = {.lex_state = 1},
+  [705] = {.lex_state = 1},
+  [706] = {.lex_state = 1},
+  [707] = {.lex_state = 1},
+  [708] = {.lex_state = 3},
+  [709] = {.lex_state = 3},
+  [710] = {.lex_state = 52},
+  [711] = {.lex_state = 52},
+  [712] = {.lex_state = 52},
+  [713] = {.lex_state = 1},
+  [714] = {.lex_state = 1},
+  [715] = {.lex_state = 1},
+  [716] = {.lex_state = 1},
+  [717] = {.lex_state = 50},
+  [718] = {.lex_state = 50},
+  [719] = {.lex_state = 3},
+  [720] = {.lex_state = 3},
+  [721] = {.lex_state = 1},
+  [722] = {.lex_state = 1},
+  [723] = {.lex_state = 1},
+  [724] = {.lex_state = 1},
+  [725] = {.lex_state = 52},
+  [726] = {.lex_state = 52},
+  [727] = {.lex_state = 50},
+  [728] = {.lex_state = 50},
+  [729] = {.lex_state = 1},
+  [730] = {.lex_state = 1},
+  [731] = {.lex_state = 3},
+  [732] = {.lex_state = 3},
+  [733] = {.lex_state = 52},
+  [734] = {.lex_state = 52},
+  [735] = {.lex_state = 1},
+  [736] = {.lex_state = 1},
+  [737] = {.lex_state = 50},
+  [738] = {.lex_state = 50},
+  [739] = {.lex_state = 52},
+  [740] = {.lex_state = 52},
+};
+
+static const uint16_t ts_parse_table[LARGE_STATE_COUNT][SYMBOL_COUNT] = {
+  [0] = {
+    [ts_builtin_sym_end] = ACTIONS(1),
+    [sym_var] = ACTIONS(1),
+    [sym_int] = ACTIONS(1),
+    [anon_sym_BQUOTE] = ACTIONS(1),
+    [anon_sym_LBRACE] = ACTIONS(1),
+    [anon_sym_COLON] = ACTIONS(1),
+    [anon_sym_RBRACE] = ACTIONS(1),
+    [sym_cons_name] = ACTIONS(1),
+    [anon_sym_LPAREN] = ACTIONS(1),
+    [anon_sym_COMMA] = ACTIONS(1),
+    [anon_sym_RPAREN] = ACTIONS(1),
+    [anon_sym_BSLASH] = ACTIONS(1),
+    [anon_sym_DASH_GT] = ACTIONS(1),
+    [anon_sym_DOT] = ACTIONS(1),
+    [anon_sym_when] = ACTIONS(1),
+    [anon_sym_is] = ACTIONS(1),
     [anon_sym_SEMI] = ACTIONS(1),
     [anon_sym_else] = ACTIONS(1),
     [anon_sym_LBRACK] = ACTIONS(1),
     [anon_sym_RBRACK] = ACTIONS(1),
     [anon_sym_EQ] = ACTIONS(1),
-    [anon_sym_LT_DASH] = ACTIONS(1),
     [anon_sym_import] = ACTIONS(1),
     [anon_sym_from] = ACTIONS(1),
+    [anon_sym_with] = ACTIONS(1),
     [anon_sym_LT] = ACTIONS(1),
     [anon_sym_GT] = ACTIONS(1),
     [sym__comment] = ACTIONS(3),
   },
   [1] = {
-    [sym_source_file] = STATE(392),
-    [sym__expr] = STATE(192),
-    [sym_str] = STATE(192),
-    [sym_app] = STATE(192),
-    [sym_iapp] = STATE(192),
-    [sym_lam] = STATE(192),
-    [sym_rec] = STATE(192),
-    [sym_prop] = STATE(192),
-    [sym_cons] = STATE(192),
-    [sym_when] = STATE(192),
-    [sym_list] = STATE(192),
-    [sym_assign] = STATE(312),
-    [sym_bind] = STATE(312),
-    [sym_annot] = STATE(312),
-    [sym_import] = STATE(312),
-    [sym__decl] = STATE(312),
-    [sym__inner_block] = STATE(357),
-    [sym_block] = STATE(192),
-    [aux_sym__inner_block_repeat1] = STATE(6),
+    [sym_source_file] = STATE(14),
+    [sym__expr] = STATE(15),
+    [sym_str] = STATE(16),
+    [sym_app] = STATE(17),
+    [sym_iapp] = STATE(18),
+    [sym_lam] = STATE(19),
+    [sym_rec] = STATE(20),
+    [sym_prop] = STATE(21),
+    [sym_cons] = STATE(22),
+    [sym_when] = STATE(23),
+    [sym_list] = STATE(24),
+    [sym_tuple] = STATE(25),
+    [sym_assign] = STATE(26),
+    [sym_bind] = STATE(27),
+    [sym_annot] = STATE(28),
+    [sym_import] = STATE(29),
+    [sym__decl] = STATE(30),
+    [sym__inner_block] = STATE(31),
+    [sym_block] = STATE(32),
+    [sym_with] = STATE(33),
+    [aux_sym__inner_block_repeat1] = STATE(34),
     [sym_var] = ACTIONS(5),
     [sym_int] = ACTIONS(7),
     [anon_sym_BQUOTE] = ACTIONS(9),
     [anon_sym_LBRACE] = ACTIONS(11),
     [sym_cons_name] = ACTIONS(13),
-    [sym_sym] = ACTIONS(7),
-    [anon_sym_LPAREN] = ACTIONS(15),
-    [anon_sym_BSLASH] = ACTIONS(17),
-    [anon_sym_when] = ACTIONS(19),
-    [anon_sym_LBRACK] = ACTIONS(21),
-    [anon_sym_import] = ACTIONS(23),
+    [sym_sym] = ACTIONS(15),
+    [anon_sym_LPAREN] = ACTIONS(17),
+    [anon_sym_BSLASH] = ACTIONS(19),
+    [anon_sym_when] = ACTIONS(21),
+    [anon_sym_LBRACK] = ACTIONS(23),
+    [anon_sym_import] = ACTIONS(25),
+    [anon_sym_with] = ACTIONS(27),
     [sym__comment] = ACTIONS(3),
   },
 };
 
 static const uint16_t ts_small_parse_table[] = {
-  [0] = 12,
+  [0] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(31), 1,
+      anon_sym_COLON,
+    ACTIONS(33), 1,
+      anon_sym_EQ,
+    ACTIONS(35), 1,
+      anon_sym_LT_DASH,
+    ACTIONS(29), 6,
+      ts_builtin_sym_end,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+  [21] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [41] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(39), 1,
+      sym_lit_str,
+    ACTIONS(41), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(43), 1,
+      anon_sym_LBRACE,
+    STATE(41), 1,
+      aux_sym_str_repeat1,
+  [57] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(45), 1,
+      sym_var,
+    ACTIONS(47), 1,
+      anon_sym_RBRACE,
+    STATE(44), 1,
+      aux_sym_rec_repeat1,
+  [70] = 24,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(46), 1,
+      sym__expr,
+    STATE(47), 1,
+      sym_tuple,
+    ACTIONS(49), 9,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_RBRACK,
+  [151] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [171] = 33,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(5), 1,
+      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(17), 1,
+      anon_sym_LPAREN,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(25), 1,
+      anon_sym_import,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(25), 1,
+      sym_tuple,
+    STATE(26), 1,
+      sym_assign,
+    STATE(27), 1,
+      sym_bind,
+    STATE(28), 1,
+      sym_annot,
+    STATE(29), 1,
+      sym_import,
+    STATE(30), 1,
+      sym__decl,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(34), 1,
+      aux_sym__inner_block_repeat1,
+    STATE(48), 1,
+      sym__expr,
+    STATE(49), 1,
+      sym__inner_block,
+  [271] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(53), 1,
+      sym_var,
+    ACTIONS(55), 1,
+      anon_sym_DASH_GT,
+    STATE(52), 1,
+      aux_sym_lam_repeat1,
+  [284] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(57), 1,
+      sym__expr,
+  [360] = 27,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(65), 1,
+      anon_sym_RBRACK,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(59), 1,
+      sym__expr,
+    STATE(60), 1,
+      aux_sym_app_repeat1,
+  [442] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(67), 1,
+      sym_var,
+  [449] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(69), 1,
+      sym_var,
+  [456] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(71), 1,
+      ts_builtin_sym_end,
+  [463] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(73), 2,
+      ts_builtin_sym_end,
+      anon_sym_RPAREN,
+  [480] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [500] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [520] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [540] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [560] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [580] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [600] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [620] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [640] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [660] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(81), 1,
+      anon_sym_EQ,
+    ACTIONS(83), 1,
+      anon_sym_LT_DASH,
+    ACTIONS(29), 6,
+      ts_builtin_sym_end,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+  [678] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(85), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [686] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(85), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [694] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(85), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [702] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(85), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [710] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(87), 1,
+      anon_sym_BSLASH,
+    ACTIONS(89), 1,
+      anon_sym_LF,
+  [720] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(91), 1,
+      ts_builtin_sym_end,
+  [727] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [747] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [767] = 31,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(5), 1,
+      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(17), 1,
+      anon_sym_LPAREN,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(25), 1,
+      anon_sym_import,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(25), 1,
+      sym_tuple,
+    STATE(26), 1,
+      sym_assign,
+    STATE(27), 1,
+      sym_bind,
+    STATE(28), 1,
+      sym_annot,
+    STATE(29), 1,
+      sym_import,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(70), 1,
+      sym__expr,
+    STATE(71), 1,
+      sym__decl,
+  [861] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(93), 1,
+      sym_var,
+    ACTIONS(95), 1,
+      anon_sym_LBRACE,
+    ACTIONS(97), 1,
+      sym_cons_name,
+    ACTIONS(99), 1,
+      anon_sym_LPAREN,
+    ACTIONS(101), 1,
+      anon_sym_LBRACK,
+    STATE(77), 1,
+      sym__type,
+    STATE(78), 1,
+      sym_type_cons,
+    STATE(79), 1,
+      sym_type_rec,
+    STATE(80), 1,
+      sym_type_union,
+    STATE(81), 1,
+      sym_type_tuple,
+  [895] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(93), 1,
+      sym__expr,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+  [971] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(106), 1,
+      sym__expr,
+  [1047] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(125), 3,
+      sym_lit_str,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+  [1056] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(129), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(127), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [1076] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(107), 1,
+      sym__expr,
+  [1152] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(131), 1,
+      sym_lit_str,
+    ACTIONS(133), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(135), 1,
+      anon_sym_LBRACE,
+  [1165] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(137), 1,
+      anon_sym_COLON,
+  [1172] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(141), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(139), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [1192] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(143), 1,
+      sym_var,
+  [1199] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [1219] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(147), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(145), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [1239] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(37), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(29), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [1259] = 7,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(73), 1,
+      anon_sym_RPAREN,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(149), 1,
+      anon_sym_COMMA,
+    STATE(114), 1,
+      aux_sym_tuple_repeat1,
+  [1281] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(151), 1,
+      anon_sym_RPAREN,
+  [1288] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(153), 1,
+      anon_sym_COMMA,
+    ACTIONS(155), 1,
+      anon_sym_DASH_GT,
+  [1298] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(118), 1,
+      sym__expr,
+  [1374] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(157), 1,
+      sym_var,
+  [1381] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    ACTIONS(159), 1,
+      anon_sym_is,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(46), 1,
+      sym__expr,
+    STATE(47), 1,
+      sym_tuple,
+    ACTIONS(49), 3,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_DOT,
+  [1459] = 33,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(5), 1,
+      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(17), 1,
+      anon_sym_LPAREN,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(25), 1,
+      anon_sym_import,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(25), 1,
+      sym_tuple,
+    STATE(26), 1,
+      sym_assign,
+    STATE(27), 1,
+      sym_bind,
+    STATE(28), 1,
+      sym_annot,
+    STATE(29), 1,
+      sym_import,
+    STATE(30), 1,
+      sym__decl,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(34), 1,
+      aux_sym__inner_block_repeat1,
+    STATE(49), 1,
+      sym__inner_block,
+    STATE(120), 1,
+      sym__expr,
+  [1559] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(161), 1,
+      sym_var,
+    ACTIONS(163), 1,
+      anon_sym_DASH_GT,
+    STATE(123), 1,
+      aux_sym_lam_repeat1,
+  [1572] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(124), 1,
+      sym__expr,
+  [1648] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(167), 1,
+      anon_sym_is,
+  [1664] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(171), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(169), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [1684] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(173), 1,
+      anon_sym_COMMA,
+    ACTIONS(175), 1,
+      anon_sym_RBRACK,
+  [1703] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(129), 1,
+      sym__expr,
+  [1779] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(177), 1,
+      anon_sym_from,
+  [1786] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(179), 1,
+      anon_sym_LPAREN,
+    ACTIONS(181), 1,
+      anon_sym_DOT,
+    STATE(133), 1,
+      sym_block,
+    STATE(134), 1,
+      aux_sym_with_repeat1,
+  [1802] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(135), 1,
+      sym__expr,
+  [1878] = 27,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(183), 1,
+      anon_sym_RPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(137), 1,
+      sym__expr,
+    STATE(138), 1,
+      aux_sym_app_repeat1,
+  [1960] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(185), 1,
+      sym_var,
+  [1967] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(140), 1,
+      sym__expr,
+  [2043] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(141), 1,
+      sym__expr,
+  [2119] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(187), 4,
+      sym_var,
+      anon_sym_when,
+      anon_sym_import,
+      anon_sym_with,
+    ACTIONS(189), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [2139] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(187), 4,
+      sym_var,
+      anon_sym_when,
+      anon_sym_import,
+      anon_sym_with,
+    ACTIONS(189), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [2159] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(191), 2,
+      ts_builtin_sym_end,
+      anon_sym_RPAREN,
+  [2176] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(193), 1,
+      anon_sym_BSLASH,
+    ACTIONS(195), 1,
+      anon_sym_LF,
+  [2186] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2194] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(199), 1,
+      sym_var,
+    ACTIONS(201), 1,
+      anon_sym_RBRACE,
+    STATE(146), 1,
+      aux_sym_type_rec_repeat1,
+  [2207] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(205), 1,
+      anon_sym_LT,
+    ACTIONS(203), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2218] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(153), 1,
+      sym__type,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+  [2252] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(217), 1,
+      sym_cons_name,
+    ACTIONS(219), 1,
+      anon_sym_RBRACK,
+    STATE(160), 1,
+      aux_sym_type_union_repeat1,
+  [2265] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(221), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2273] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2281] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2289] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2297] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2305] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2316] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2327] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(39), 1,
+      sym_lit_str,
+    ACTIONS(43), 1,
+      anon_sym_LBRACE,
+    ACTIONS(223), 1,
+      anon_sym_BQUOTE,
+    STATE(162), 1,
+      aux_sym_str_repeat1,
+  [2343] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(225), 1,
+      sym_var,
+    ACTIONS(227), 1,
+      anon_sym_RBRACE,
+    STATE(165), 1,
+      aux_sym_rec_repeat1,
+  [2356] = 23,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(166), 1,
+      sym__expr,
+    ACTIONS(49), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2430] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2441] = 33,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(5), 1,
+      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(17), 1,
+      anon_sym_LPAREN,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(25), 1,
+      anon_sym_import,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(25), 1,
+      sym_tuple,
+    STATE(26), 1,
+      sym_assign,
+    STATE(27), 1,
+      sym_bind,
+    STATE(28), 1,
+      sym_annot,
+    STATE(29), 1,
+      sym_import,
+    STATE(30), 1,
+      sym__decl,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(34), 1,
+      aux_sym__inner_block_repeat1,
+    STATE(167), 1,
+      sym__expr,
+    STATE(168), 1,
+      sym__inner_block,
+  [2541] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(229), 1,
+      sym_var,
+    ACTIONS(231), 1,
+      anon_sym_DASH_GT,
+    STATE(171), 1,
+      aux_sym_lam_repeat1,
+  [2554] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(172), 1,
+      sym__expr,
+  [2630] = 27,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(233), 1,
+      anon_sym_RBRACK,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(174), 1,
+      sym__expr,
+    STATE(175), 1,
+      aux_sym_app_repeat1,
+  [2712] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(235), 1,
+      sym_var,
+  [2719] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(241), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2736] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2747] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2758] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2769] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2780] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2791] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2802] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2813] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2824] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2835] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2846] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2857] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(29), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [2868] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(245), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [2885] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(247), 1,
+      anon_sym_COLON,
+    ACTIONS(249), 1,
+      anon_sym_RBRACE,
+  [2904] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(251), 3,
+      sym_lit_str,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+  [2913] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(255), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(253), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [2933] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(182), 1,
+      sym__expr,
+  [3009] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(183), 1,
+      sym__expr,
+  [3085] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(257), 1,
+      anon_sym_COLON,
+  [3092] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(185), 1,
+      sym__expr,
+  [3168] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(259), 1,
+      anon_sym_COMMA,
+    ACTIONS(261), 1,
+      anon_sym_RPAREN,
+  [3178] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(265), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(263), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [3198] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(267), 1,
+      sym_var,
+    ACTIONS(269), 1,
+      anon_sym_DASH_GT,
+  [3208] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(189), 1,
+      sym__expr,
+  [3284] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [3305] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(273), 1,
+      anon_sym_COMMA,
+    ACTIONS(275), 1,
+      anon_sym_DASH_GT,
+  [3315] = 7,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(73), 1,
+      anon_sym_RPAREN,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(149), 1,
+      anon_sym_COMMA,
+    STATE(192), 1,
+      aux_sym_tuple_repeat1,
+  [3337] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(277), 1,
+      anon_sym_COMMA,
+    ACTIONS(279), 1,
+      anon_sym_DASH_GT,
+  [3347] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(195), 1,
+      sym__expr,
+  [3423] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(281), 1,
+      sym_var,
+  [3430] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(283), 1,
+      anon_sym_is,
+  [3446] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(135), 1,
+      sym__expr,
+  [3522] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(285), 1,
+      sym_cons_name,
+    STATE(199), 1,
+      aux_sym_when_repeat1,
+  [3532] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(291), 1,
+      anon_sym_RBRACK,
+    ACTIONS(287), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(289), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [3554] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(295), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(293), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [3574] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(297), 1,
+      anon_sym_COMMA,
+    ACTIONS(299), 1,
+      anon_sym_RBRACK,
+  [3593] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(301), 1,
+      anon_sym_BQUOTE,
+  [3600] = 33,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(5), 1,
+      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(17), 1,
+      anon_sym_LPAREN,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(25), 1,
+      anon_sym_import,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    STATE(15), 1,
+      sym__expr,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(25), 1,
+      sym_tuple,
+    STATE(26), 1,
+      sym_assign,
+    STATE(27), 1,
+      sym_bind,
+    STATE(28), 1,
+      sym_annot,
+    STATE(29), 1,
+      sym_import,
+    STATE(30), 1,
+      sym__decl,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(34), 1,
+      aux_sym__inner_block_repeat1,
+    STATE(49), 1,
+      sym__inner_block,
+  [3700] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(303), 1,
+      sym_var,
+  [3707] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(307), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(305), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [3727] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(179), 1,
+      anon_sym_LPAREN,
+    ACTIONS(309), 1,
+      anon_sym_DOT,
+    STATE(206), 1,
+      sym_block,
+  [3740] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(313), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(311), 8,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [3764] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(317), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(315), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [3784] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(319), 1,
+      anon_sym_COMMA,
+    ACTIONS(321), 1,
+      anon_sym_RPAREN,
+  [3803] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(209), 1,
+      sym__expr,
+  [3879] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(325), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(323), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [3899] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(241), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [3916] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(245), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [3933] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(327), 4,
+      sym_var,
+      anon_sym_when,
+      anon_sym_import,
+      anon_sym_with,
+    ACTIONS(329), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [3953] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(327), 4,
+      sym_var,
+      anon_sym_when,
+      anon_sym_import,
+      anon_sym_with,
+    ACTIONS(329), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [3973] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(331), 1,
+      anon_sym_COLON,
+  [3980] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(333), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [3988] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(335), 1,
+      sym_var,
+  [3995] = 13,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(337), 1,
+      sym_var,
+    ACTIONS(339), 1,
+      anon_sym_LBRACE,
+    ACTIONS(341), 1,
+      sym_cons_name,
+    ACTIONS(343), 1,
+      anon_sym_LPAREN,
+    ACTIONS(345), 1,
+      anon_sym_LBRACK,
+    ACTIONS(347), 1,
+      anon_sym_GT,
+    STATE(218), 1,
+      sym__type,
+    STATE(219), 1,
+      sym_type_cons,
+    STATE(220), 1,
+      sym_type_rec,
+    STATE(221), 1,
+      sym_type_union,
+    STATE(222), 1,
+      sym_type_tuple,
+    STATE(223), 1,
+      aux_sym_type_cons_repeat1,
+  [4035] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [4045] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(349), 1,
+      sym_var,
+    ACTIONS(351), 1,
+      anon_sym_RBRACE,
+    STATE(226), 1,
+      aux_sym_type_rec_repeat1,
+  [4058] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(353), 1,
+      anon_sym_LT,
+    ACTIONS(203), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [4071] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(228), 1,
+      sym__type,
+  [4105] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(355), 1,
+      sym_cons_name,
+    ACTIONS(357), 1,
+      anon_sym_RBRACK,
+    STATE(231), 1,
+      aux_sym_type_union_repeat1,
+  [4118] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(359), 1,
+      anon_sym_COMMA,
+    STATE(233), 1,
+      aux_sym_type_tuple_repeat1,
+  [4128] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [4138] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [4148] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [4158] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [4168] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(234), 1,
+      sym__type,
+  [4202] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(361), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [4210] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(363), 1,
+      sym_cons_name,
+  [4217] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(127), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [4228] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(131), 1,
+      sym_lit_str,
+    ACTIONS(135), 1,
+      anon_sym_LBRACE,
+    ACTIONS(365), 1,
+      anon_sym_BQUOTE,
+  [4241] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(367), 1,
+      anon_sym_COLON,
+  [4248] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(139), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [4259] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(369), 1,
+      sym_var,
+  [4266] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(145), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [4277] = 7,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(73), 1,
+      anon_sym_RPAREN,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(149), 1,
+      anon_sym_COMMA,
+    STATE(239), 1,
+      aux_sym_tuple_repeat1,
+  [4299] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(371), 1,
+      anon_sym_RPAREN,
+  [4306] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(373), 1,
+      anon_sym_COMMA,
+    ACTIONS(375), 1,
+      anon_sym_DASH_GT,
+  [4316] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(243), 1,
+      sym__expr,
+  [4392] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(377), 1,
+      sym_var,
+  [4399] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(379), 1,
+      anon_sym_is,
+  [4415] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(169), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [4426] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(381), 1,
+      anon_sym_COMMA,
+    ACTIONS(383), 1,
+      anon_sym_RBRACK,
+  [4445] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(248), 1,
+      sym__expr,
+  [4521] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(181), 1,
+      anon_sym_DOT,
+    ACTIONS(385), 1,
+      anon_sym_LPAREN,
+    STATE(250), 1,
+      sym_block,
+    STATE(251), 1,
+      aux_sym_with_repeat1,
+  [4537] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(252), 1,
+      sym__expr,
+  [4613] = 27,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(387), 1,
+      anon_sym_RPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(254), 1,
+      sym__expr,
+    STATE(255), 1,
+      aux_sym_app_repeat1,
+  [4695] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(389), 1,
+      sym_var,
+  [4702] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(391), 1,
+      sym_fmt_spec,
+  [4709] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(393), 3,
+      sym_lit_str,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+  [4718] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(395), 1,
+      anon_sym_COLON,
+    ACTIONS(397), 1,
+      anon_sym_RBRACE,
+  [4737] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(399), 1,
+      anon_sym_RBRACE,
+    ACTIONS(401), 1,
+      anon_sym_COMMA,
+  [4756] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(262), 1,
+      sym__expr,
+  [4832] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(403), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [4849] = 26,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(405), 1,
+      anon_sym_RPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(264), 1,
+      sym__expr,
+  [4928] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(407), 8,
+      ts_builtin_sym_end,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_EQ,
+      anon_sym_LT_DASH,
+  [4942] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(265), 1,
+      sym__expr,
+  [5018] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(409), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [5039] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(411), 1,
+      sym_var,
+    ACTIONS(413), 1,
+      anon_sym_DASH_GT,
+  [5049] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(267), 1,
+      sym__expr,
+  [5125] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(415), 1,
+      anon_sym_COMMA,
+    ACTIONS(417), 1,
+      anon_sym_RPAREN,
+  [5135] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(267), 1,
+      sym_var,
+    ACTIONS(419), 1,
+      anon_sym_DASH_GT,
+  [5145] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(271), 1,
+      sym__expr,
+  [5221] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(421), 1,
+      anon_sym_is,
+  [5237] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(423), 1,
+      anon_sym_COMMA,
+    ACTIONS(425), 1,
+      anon_sym_DASH_GT,
+  [5247] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(427), 1,
+      sym_cons_name,
+    STATE(275), 1,
+      aux_sym_when_repeat1,
+  [5257] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(429), 1,
+      sym_var,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    STATE(278), 1,
+      sym_tuple,
+  [5270] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(433), 1,
+      sym_cons_name,
+  [5277] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(437), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(435), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [5297] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(443), 1,
+      anon_sym_RBRACK,
+    ACTIONS(439), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(441), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [5319] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(437), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(435), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [5339] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(445), 1,
+      sym_lit_str,
+  [5346] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(447), 2,
+      anon_sym_LPAREN,
+      anon_sym_DOT,
+  [5354] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(449), 1,
+      sym_var,
+  [5361] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(453), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(451), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [5381] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(455), 1,
+      anon_sym_RPAREN,
+    ACTIONS(287), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(289), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [5403] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(459), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(457), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [5423] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(461), 1,
+      anon_sym_COMMA,
+    ACTIONS(463), 1,
+      anon_sym_RPAREN,
+  [5442] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(286), 1,
+      sym__type,
+  [5476] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(465), 1,
+      anon_sym_COLON,
+  [5483] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [5491] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(467), 1,
+      sym_var,
+    ACTIONS(469), 1,
+      anon_sym_RBRACE,
+    STATE(290), 1,
+      aux_sym_type_rec_repeat1,
+  [5504] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(471), 1,
+      anon_sym_LT,
+    ACTIONS(203), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [5515] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(292), 1,
+      sym__type,
+  [5549] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(473), 1,
+      sym_cons_name,
+    ACTIONS(475), 1,
+      anon_sym_RBRACK,
+    STATE(295), 1,
+      aux_sym_type_union_repeat1,
+  [5562] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(477), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [5570] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(479), 1,
+      anon_sym_COMMA2,
+    ACTIONS(481), 1,
+      anon_sym_GT,
+  [5580] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [5588] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [5596] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [5604] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(197), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [5612] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(337), 1,
+      sym_var,
+    ACTIONS(339), 1,
+      anon_sym_LBRACE,
+    ACTIONS(341), 1,
+      sym_cons_name,
+    ACTIONS(343), 1,
+      anon_sym_LPAREN,
+    ACTIONS(345), 1,
+      anon_sym_LBRACK,
+    STATE(219), 1,
+      sym_type_cons,
+    STATE(220), 1,
+      sym_type_rec,
+    STATE(221), 1,
+      sym_type_union,
+    STATE(222), 1,
+      sym_type_tuple,
+    STATE(298), 1,
+      sym__type,
+  [5646] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(483), 1,
+      anon_sym_COLON,
+  [5653] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(333), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [5663] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(485), 1,
+      sym_var,
+  [5670] = 13,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(337), 1,
+      sym_var,
+    ACTIONS(339), 1,
+      anon_sym_LBRACE,
+    ACTIONS(341), 1,
+      sym_cons_name,
+    ACTIONS(343), 1,
+      anon_sym_LPAREN,
+    ACTIONS(345), 1,
+      anon_sym_LBRACK,
+    ACTIONS(487), 1,
+      anon_sym_GT,
+    STATE(219), 1,
+      sym_type_cons,
+    STATE(220), 1,
+      sym_type_rec,
+    STATE(221), 1,
+      sym_type_union,
+    STATE(222), 1,
+      sym_type_tuple,
+    STATE(302), 1,
+      sym__type,
+    STATE(303), 1,
+      aux_sym_type_cons_repeat1,
+  [5710] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(359), 1,
+      anon_sym_COMMA,
+    STATE(304), 1,
+      aux_sym_type_tuple_repeat1,
+  [5720] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(305), 1,
+      sym__type,
+  [5754] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(361), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [5764] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(489), 1,
+      sym_cons_name,
+  [5771] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(307), 1,
+      sym__type,
+  [5805] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(491), 1,
+      anon_sym_COMMA,
+    ACTIONS(493), 1,
+      anon_sym_RPAREN,
+  [5815] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(495), 1,
+      anon_sym_COMMA,
+    ACTIONS(497), 1,
+      anon_sym_RBRACK,
+  [5825] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(312), 1,
+      sym__type,
+  [5859] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(253), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [5870] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(313), 1,
+      sym__expr,
+  [5946] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(499), 1,
+      anon_sym_COLON,
+  [5953] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(501), 1,
+      anon_sym_COMMA,
+    ACTIONS(503), 1,
+      anon_sym_RPAREN,
+  [5963] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(263), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [5974] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(267), 1,
+      sym_var,
+    ACTIONS(505), 1,
+      anon_sym_DASH_GT,
+  [5984] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(318), 1,
+      sym__expr,
+  [6060] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(271), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [6077] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(507), 1,
+      anon_sym_COMMA,
+    ACTIONS(509), 1,
+      anon_sym_DASH_GT,
+  [6087] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(511), 1,
+      sym_cons_name,
+    STATE(322), 1,
+      aux_sym_when_repeat1,
+  [6097] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(513), 1,
+      anon_sym_RBRACK,
+    ACTIONS(287), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(289), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [6119] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(293), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [6130] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(515), 1,
+      anon_sym_COMMA,
+    ACTIONS(517), 1,
+      anon_sym_RBRACK,
+  [6149] = 33,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(5), 1,
+      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(17), 1,
+      anon_sym_LPAREN,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
     ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
+    ACTIONS(25), 1,
+      anon_sym_import,
     ACTIONS(27), 1,
-      sym_var,
-    ACTIONS(29), 1,
-      sym_int,
-    ACTIONS(31), 1,
-      sym_cons_name,
-    ACTIONS(33), 1,
+      anon_sym_with,
+    STATE(15), 1,
+      sym__expr,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(25), 1,
+      sym_tuple,
+    STATE(26), 1,
+      sym_assign,
+    STATE(27), 1,
+      sym_bind,
+    STATE(28), 1,
+      sym_annot,
+    STATE(29), 1,
+      sym_import,
+    STATE(30), 1,
+      sym__decl,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(34), 1,
+      aux_sym__inner_block_repeat1,
+    STATE(168), 1,
+      sym__inner_block,
+  [6249] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(305), 5,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
-      anon_sym_when,
-    ACTIONS(37), 2,
-      anon_sym_is,
-      anon_sym_else,
-    ACTIONS(25), 9,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [6260] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(309), 1,
+      anon_sym_DOT,
+    ACTIONS(385), 1,
+      anon_sym_LPAREN,
+    STATE(326), 1,
+      sym_block,
+  [6273] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(311), 3,
+      sym_sym,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [6288] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(315), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [6299] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
       sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(519), 1,
       anon_sym_COMMA,
+    ACTIONS(521), 1,
       anon_sym_RPAREN,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_RBRACK,
-    STATE(80), 11,
-      sym__expr,
+  [6318] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [56] = 15,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(329), 1,
+      sym__expr,
+  [6394] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(5), 1,
+    ACTIONS(323), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [6405] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(523), 1,
+      anon_sym_RBRACE,
+  [6412] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(525), 1,
+      sym_fmt_spec,
+  [6419] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(527), 3,
+      sym_lit_str,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+  [6428] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(531), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(529), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [6448] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(533), 1,
       sym_var,
+    ACTIONS(535), 1,
+      anon_sym_RBRACE,
+  [6458] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(537), 1,
+      anon_sym_RBRACE,
+    ACTIONS(539), 1,
+      anon_sym_COMMA,
+  [6477] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(541), 8,
+      ts_builtin_sym_end,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_EQ,
+      anon_sym_LT_DASH,
+  [6491] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(543), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [6508] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(545), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [6529] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
@@ -1837,45 +8138,66 @@ static const uint16_t ts_small_parse_table[] = {
     ACTIONS(13), 1,
       sym_cons_name,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
+      sym_sym,
     ACTIONS(19), 1,
-      anon_sym_when,
+      anon_sym_BSLASH,
     ACTIONS(21), 1,
-      anon_sym_LBRACK,
+      anon_sym_when,
     ACTIONS(23), 1,
-      anon_sym_import,
-    STATE(6), 1,
-      aux_sym__inner_block_repeat1,
-    STATE(362), 1,
-      sym__inner_block,
-    ACTIONS(7), 2,
-      sym_int,
-      sym_sym,
-    STATE(312), 5,
-      sym_assign,
-      sym_bind,
-      sym_annot,
-      sym_import,
-      sym__decl,
-    STATE(192), 11,
-      sym__expr,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [117] = 15,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(335), 1,
+      sym__expr,
+  [6605] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(5), 1,
-      sym_var,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(545), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [6626] = 26,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
@@ -1883,166 +8205,208 @@ static const uint16_t ts_small_parse_table[] = {
     ACTIONS(13), 1,
       sym_cons_name,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
+      sym_sym,
     ACTIONS(19), 1,
-      anon_sym_when,
+      anon_sym_BSLASH,
     ACTIONS(21), 1,
-      anon_sym_LBRACK,
+      anon_sym_when,
     ACTIONS(23), 1,
-      anon_sym_import,
-    STATE(6), 1,
-      aux_sym__inner_block_repeat1,
-    STATE(396), 1,
-      sym__inner_block,
-    ACTIONS(7), 2,
-      sym_int,
-      sym_sym,
-    STATE(312), 5,
-      sym_assign,
-      sym_bind,
-      sym_annot,
-      sym_import,
-      sym__decl,
-    STATE(192), 11,
-      sym__expr,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(547), 1,
+      anon_sym_RPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [178] = 12,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(264), 1,
+      sym__expr,
+  [6705] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(27), 1,
-      sym_var,
-    ACTIONS(29), 1,
-      sym_int,
-    ACTIONS(37), 1,
+    ACTIONS(549), 2,
       anon_sym_is,
-    ACTIONS(25), 8,
+      anon_sym_else,
+    ACTIONS(407), 10,
       ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
       sym_sym,
       anon_sym_LPAREN,
       anon_sym_COMMA,
       anon_sym_RPAREN,
       anon_sym_DOT,
+      anon_sym_SEMI,
       anon_sym_RBRACK,
-    STATE(80), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [232] = 14,
+  [6725] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(5), 1,
-      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
     ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
+    ACTIONS(63), 1,
       anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(23), 1,
-      anon_sym_import,
-    STATE(71), 1,
-      aux_sym__inner_block_repeat1,
-    ACTIONS(39), 2,
-      sym_int,
-      sym_sym,
-    STATE(312), 5,
-      sym_assign,
-      sym_bind,
-      sym_annot,
-      sym_import,
-      sym__decl,
-    STATE(180), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [290] = 10,
-    ACTIONS(25), 1,
-      anon_sym_LF,
-    ACTIONS(43), 1,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(337), 1,
+      sym__expr,
+  [6801] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(551), 1,
+      anon_sym_is,
+  [6817] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(411), 1,
+      sym_var,
+    ACTIONS(553), 1,
+      anon_sym_DASH_GT,
+  [6827] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    ACTIONS(45), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(47), 1,
-      sym_cons_name,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(51), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(41), 2,
-      sym_int,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(37), 6,
-      sym_sym,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-    STATE(147), 11,
-      sym__expr,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [337] = 13,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(339), 1,
+      sym__expr,
+  [6903] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    ACTIONS(555), 1,
+      sym_var,
+    STATE(341), 1,
+      sym_tuple,
+  [6916] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(557), 1,
+      sym_cons_name,
+  [6923] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(559), 1,
+      anon_sym_DASH_GT,
+  [6930] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
@@ -2050,294 +8414,454 @@ static const uint16_t ts_small_parse_table[] = {
     ACTIONS(13), 1,
       sym_cons_name,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
+      sym_sym,
     ACTIONS(19), 1,
-      anon_sym_when,
+      anon_sym_BSLASH,
     ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(55), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
     ACTIONS(59), 1,
-      anon_sym_RBRACK,
-    STATE(13), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(57), 2,
-      sym_int,
-      sym_sym,
-    STATE(201), 11,
-      sym__expr,
+      anon_sym_LPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [388] = 13,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(344), 1,
+      sym__expr,
+  [7006] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
+    ACTIONS(561), 1,
+      anon_sym_DASH_GT,
+  [7013] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    ACTIONS(563), 1,
+      sym_var,
+    STATE(347), 1,
+      sym_tuple,
+  [7026] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(567), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(565), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [7046] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(569), 1,
+      anon_sym_BQUOTE,
+  [7053] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(571), 2,
+      anon_sym_LPAREN,
+      anon_sym_DOT,
+  [7061] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(575), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(573), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [7081] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(577), 1,
+      anon_sym_RPAREN,
+    ACTIONS(439), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(441), 8,
+      sym_int,
       anon_sym_BQUOTE,
-    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
       anon_sym_LBRACK,
-    ACTIONS(61), 1,
+  [7103] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(575), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(573), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [7123] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(579), 1,
+      anon_sym_RBRACE,
+    ACTIONS(581), 1,
+      anon_sym_COMMA,
+  [7133] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
+      anon_sym_LBRACE,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
+      anon_sym_LBRACK,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(352), 1,
+      sym__type,
+  [7167] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(583), 1,
+      anon_sym_COLON,
+  [7174] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(333), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [7182] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(585), 1,
+      sym_var,
+  [7189] = 13,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(337), 1,
+      sym_var,
+    ACTIONS(339), 1,
+      anon_sym_LBRACE,
+    ACTIONS(341), 1,
+      sym_cons_name,
+    ACTIONS(343), 1,
+      anon_sym_LPAREN,
+    ACTIONS(345), 1,
+      anon_sym_LBRACK,
+    ACTIONS(587), 1,
+      anon_sym_GT,
+    STATE(219), 1,
+      sym_type_cons,
+    STATE(220), 1,
+      sym_type_rec,
+    STATE(221), 1,
+      sym_type_union,
+    STATE(222), 1,
+      sym_type_tuple,
+    STATE(356), 1,
+      sym__type,
+    STATE(357), 1,
+      aux_sym_type_cons_repeat1,
+  [7229] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(359), 1,
+      anon_sym_COMMA,
+    STATE(358), 1,
+      aux_sym_type_tuple_repeat1,
+  [7239] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
       sym_var,
-    ACTIONS(65), 1,
-      anon_sym_RPAREN,
-    STATE(15), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(63), 2,
-      sym_int,
-      sym_sym,
-    STATE(200), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [439] = 10,
-    ACTIONS(25), 1,
-      anon_sym_LF,
-    ACTIONS(43), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(45), 1,
+    ACTIONS(209), 1,
       anon_sym_LBRACE,
-    ACTIONS(51), 1,
+    ACTIONS(211), 1,
+      sym_cons_name,
+    ACTIONS(213), 1,
+      anon_sym_LPAREN,
+    ACTIONS(215), 1,
       anon_sym_LBRACK,
-    ACTIONS(53), 1,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(359), 1,
+      sym__type,
+  [7273] = 2,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(67), 1,
+    ACTIONS(361), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [7281] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(589), 1,
       sym_cons_name,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(41), 2,
-      sym_int,
+  [7288] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(591), 1,
       sym_var,
-    ACTIONS(37), 4,
-      sym_sym,
+    ACTIONS(595), 1,
+      anon_sym_GT,
+    ACTIONS(593), 4,
+      anon_sym_LBRACE,
+      sym_cons_name,
       anon_sym_LPAREN,
+      anon_sym_LBRACK,
+  [7304] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(597), 2,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-    STATE(147), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [484] = 13,
+      anon_sym_LF,
+  [7312] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(599), 1,
+      anon_sym_COMMA2,
+    ACTIONS(601), 1,
+      anon_sym_GT,
+  [7322] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(211), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(213), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
+    ACTIONS(215), 1,
       anon_sym_LBRACK,
-    ACTIONS(71), 1,
-      sym_var,
-    ACTIONS(75), 1,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(364), 1,
+      sym__type,
+  [7356] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(603), 1,
+      anon_sym_COLON,
+  [7363] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(477), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
       anon_sym_RBRACK,
-    STATE(16), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(73), 2,
-      sym_int,
-      sym_sym,
-    STATE(174), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [535] = 13,
+  [7373] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(605), 1,
+      anon_sym_COMMA2,
+    ACTIONS(607), 1,
+      anon_sym_GT,
+  [7383] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(337), 1,
+      sym_var,
+    ACTIONS(339), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(341), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(343), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
+    ACTIONS(345), 1,
       anon_sym_LBRACK,
-    ACTIONS(77), 1,
-      sym_var,
-    ACTIONS(81), 1,
+    STATE(219), 1,
+      sym_type_cons,
+    STATE(220), 1,
+      sym_type_rec,
+    STATE(221), 1,
+      sym_type_union,
+    STATE(222), 1,
+      sym_type_tuple,
+    STATE(368), 1,
+      sym__type,
+  [7417] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(491), 1,
+      anon_sym_COMMA,
+    ACTIONS(609), 1,
       anon_sym_RPAREN,
-    STATE(17), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(79), 2,
-      sym_int,
-      sym_sym,
-    STATE(184), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [586] = 12,
+  [7427] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(611), 1,
+      anon_sym_COMMA,
+    ACTIONS(613), 1,
+      anon_sym_RBRACK,
+  [7437] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(211), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(213), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
+    ACTIONS(215), 1,
       anon_sym_LBRACK,
-    ACTIONS(83), 1,
-      sym_var,
-    STATE(14), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(85), 2,
-      sym_int,
-      sym_sym,
-    STATE(202), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [634] = 12,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(372), 1,
+      sym__type,
+  [7471] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(87), 1,
+    ACTIONS(615), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [7479] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
       sym_var,
-    ACTIONS(93), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(96), 1,
+    ACTIONS(209), 1,
       anon_sym_LBRACE,
-    ACTIONS(99), 1,
+    ACTIONS(211), 1,
       sym_cons_name,
-    ACTIONS(102), 1,
+    ACTIONS(213), 1,
       anon_sym_LPAREN,
-    ACTIONS(105), 1,
-      anon_sym_BSLASH,
-    ACTIONS(108), 1,
-      anon_sym_when,
-    ACTIONS(111), 1,
+    ACTIONS(215), 1,
       anon_sym_LBRACK,
-    STATE(14), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(90), 2,
-      sym_int,
-      sym_sym,
-    STATE(215), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [682] = 12,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(373), 1,
+      sym__type,
+  [7513] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(617), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [7521] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(619), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(621), 1,
+      anon_sym_RBRACK,
+  [7531] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(623), 2,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(114), 1,
-      sym_var,
-    STATE(14), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(116), 2,
-      sym_int,
-      sym_sym,
-    STATE(186), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [730] = 12,
+      anon_sym_LF,
+  [7539] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(625), 1,
+      anon_sym_COMMA,
+    ACTIONS(627), 1,
+      anon_sym_RBRACK,
+  [7549] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(629), 1,
+      anon_sym_RBRACE,
+    ACTIONS(631), 1,
+      anon_sym_COMMA,
+  [7568] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
@@ -2345,35 +8869,50 @@ static const uint16_t ts_small_parse_table[] = {
     ACTIONS(13), 1,
       sym_cons_name,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
+      sym_sym,
     ACTIONS(19), 1,
-      anon_sym_when,
+      anon_sym_BSLASH,
     ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(118), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    STATE(14), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(120), 2,
-      sym_int,
-      sym_sym,
-    STATE(183), 11,
-      sym__expr,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [778] = 12,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(379), 1,
+      sym__expr,
+  [7644] = 26,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
@@ -2381,6102 +8920,9954 @@ static const uint16_t ts_small_parse_table[] = {
     ACTIONS(13), 1,
       sym_cons_name,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
+      sym_sym,
     ACTIONS(19), 1,
-      anon_sym_when,
+      anon_sym_BSLASH,
     ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(122), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    STATE(14), 1,
-      aux_sym_app_repeat1,
-    ACTIONS(124), 2,
-      sym_int,
-      sym_sym,
-    STATE(187), 11,
-      sym__expr,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(633), 1,
+      anon_sym_RPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [826] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(264), 1,
+      sym__expr,
+  [7723] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(126), 1,
+    ACTIONS(407), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [7734] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
       sym_var,
-    ACTIONS(130), 1,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(109), 1,
       anon_sym_LBRACE,
-    ACTIONS(134), 1,
+    ACTIONS(111), 1,
       sym_cons_name,
-    ACTIONS(136), 1,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
+    ACTIONS(117), 1,
       anon_sym_BSLASH,
-    ACTIONS(140), 1,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
       anon_sym_LBRACK,
-    ACTIONS(128), 2,
-      sym_int,
-      sym_sym,
-    STATE(152), 11,
-      sym__expr,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
       sym_str,
+    STATE(95), 1,
       sym_app,
+    STATE(96), 1,
       sym_iapp,
+    STATE(97), 1,
       sym_lam,
+    STATE(98), 1,
       sym_rec,
+    STATE(99), 1,
       sym_prop,
+    STATE(100), 1,
       sym_cons,
+    STATE(101), 1,
       sym_when,
+    STATE(102), 1,
       sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
       sym_block,
-  [871] = 11,
+    STATE(105), 1,
+      sym_with,
+    STATE(381), 1,
+      sym__expr,
+  [7810] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(136), 1,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
       anon_sym_LPAREN,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(142), 1,
-      sym_var,
-    ACTIONS(146), 1,
-      sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(409), 2,
       anon_sym_BSLASH,
-    ACTIONS(144), 2,
+      anon_sym_LF,
+  [7827] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(411), 1,
+      sym_var,
+    ACTIONS(635), 1,
+      anon_sym_DASH_GT,
+  [7837] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
       sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
       sym_sym,
-    STATE(144), 11,
-      sym__expr,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
       sym_str,
+    STATE(95), 1,
       sym_app,
+    STATE(96), 1,
       sym_iapp,
+    STATE(97), 1,
       sym_lam,
+    STATE(98), 1,
       sym_rec,
+    STATE(99), 1,
       sym_prop,
+    STATE(100), 1,
       sym_cons,
+    STATE(101), 1,
       sym_when,
+    STATE(102), 1,
       sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
       sym_block,
-  [916] = 11,
+    STATE(105), 1,
+      sym_with,
+    STATE(383), 1,
+      sym__expr,
+  [7913] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    ACTIONS(637), 1,
+      sym_var,
+    STATE(385), 1,
+      sym_tuple,
+  [7926] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(639), 1,
+      sym_cons_name,
+  [7933] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(435), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [7944] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(641), 1,
+      anon_sym_RBRACK,
+    ACTIONS(439), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(441), 8,
+      sym_int,
       anon_sym_BQUOTE,
-    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
       anon_sym_LBRACK,
-    ACTIONS(150), 1,
-      sym_var,
-    ACTIONS(152), 2,
-      sym_int,
+  [7966] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(435), 5,
       sym_sym,
-    STATE(177), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [961] = 11,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [7977] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
+    ACTIONS(451), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [7988] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(643), 1,
+      anon_sym_RPAREN,
+    ACTIONS(287), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(289), 8,
+      sym_int,
       anon_sym_BQUOTE,
-    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
       anon_sym_LBRACK,
-    ACTIONS(154), 1,
+  [8010] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(457), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [8021] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(645), 1,
+      anon_sym_COMMA,
+    ACTIONS(647), 1,
+      anon_sym_RPAREN,
+  [8040] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(649), 3,
+      sym_lit_str,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+  [8049] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(651), 1,
+      anon_sym_RBRACE,
+  [8056] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(655), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(653), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [8076] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(655), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(653), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [8096] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(657), 1,
       sym_var,
-    ACTIONS(156), 2,
-      sym_int,
+    ACTIONS(659), 1,
+      anon_sym_RBRACE,
+  [8106] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
       sym_sym,
-    STATE(118), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1006] = 11,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(661), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [8127] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(663), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(541), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [8147] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(665), 1,
+      anon_sym_is,
+  [8163] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(158), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(160), 2,
-      sym_int,
-      sym_sym,
-    STATE(213), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1051] = 11,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(57), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
+    ACTIONS(63), 1,
       anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(162), 1,
-      sym_var,
-    ACTIONS(164), 2,
-      sym_int,
-      sym_sym,
-    STATE(191), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [1096] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(393), 1,
+      sym__expr,
+  [8239] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(166), 1,
-      sym_var,
-    ACTIONS(168), 2,
-      sym_int,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
       sym_sym,
-    STATE(120), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1141] = 11,
+    ACTIONS(665), 1,
+      anon_sym_is,
+  [8255] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(667), 1,
+      anon_sym_DASH_GT,
+  [8262] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(669), 1,
+      anon_sym_DASH_GT,
+  [8269] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(431), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(170), 1,
+    ACTIONS(671), 1,
       sym_var,
-    ACTIONS(172), 2,
-      sym_int,
-      sym_sym,
-    STATE(209), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1186] = 11,
+    STATE(397), 1,
+      sym_tuple,
+  [8282] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
     ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
+    ACTIONS(677), 1,
       anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(174), 1,
-      sym_var,
-    ACTIONS(176), 2,
-      sym_int,
-      sym_sym,
-    STATE(117), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [1231] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(401), 1,
+      sym__expr,
+  [8358] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(149), 1,
+      anon_sym_COMMA,
+    STATE(402), 1,
+      aux_sym_tuple_repeat1,
+  [8377] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(21), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(31), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
       sym_cons_name,
-    ACTIONS(33), 1,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
+    ACTIONS(677), 1,
       anon_sym_when,
-    ACTIONS(178), 1,
-      sym_var,
-    ACTIONS(180), 2,
-      sym_int,
-      sym_sym,
-    STATE(98), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [1276] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(403), 1,
+      sym__expr,
+  [8453] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(15), 1,
+    ACTIONS(679), 1,
+      anon_sym_DASH_GT,
+  [8460] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(681), 1,
+      anon_sym_DASH_GT,
+  [8467] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(683), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [8475] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(687), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(685), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      sym_cons_name,
-    ACTIONS(33), 1,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [8495] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(689), 2,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
-      anon_sym_when,
-    ACTIONS(182), 1,
+      anon_sym_LF,
+  [8503] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(691), 1,
       sym_var,
-    ACTIONS(184), 2,
-      sym_int,
-      sym_sym,
-    STATE(106), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1321] = 11,
+    ACTIONS(693), 1,
+      anon_sym_RBRACE,
+  [8513] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(695), 1,
+      anon_sym_RBRACE,
+    ACTIONS(697), 1,
+      anon_sym_COMMA,
+  [8523] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(211), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(213), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
+    ACTIONS(215), 1,
       anon_sym_LBRACK,
-    ACTIONS(186), 1,
-      sym_var,
-    ACTIONS(188), 2,
-      sym_int,
-      sym_sym,
-    STATE(122), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1366] = 11,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(409), 1,
+      sym__type,
+  [8557] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(699), 1,
+      anon_sym_COLON,
+  [8564] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(477), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [8572] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(701), 1,
+      anon_sym_COMMA2,
+    ACTIONS(703), 1,
+      anon_sym_GT,
+  [8582] = 11,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(337), 1,
+      sym_var,
+    ACTIONS(339), 1,
       anon_sym_LBRACE,
-    ACTIONS(15), 1,
+    ACTIONS(341), 1,
+      sym_cons_name,
+    ACTIONS(343), 1,
       anon_sym_LPAREN,
-    ACTIONS(21), 1,
+    ACTIONS(345), 1,
       anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      sym_cons_name,
-    ACTIONS(33), 1,
-      anon_sym_BSLASH,
-    ACTIONS(35), 1,
-      anon_sym_when,
-    ACTIONS(190), 1,
-      sym_var,
-    ACTIONS(192), 2,
-      sym_int,
-      sym_sym,
-    STATE(203), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1411] = 11,
+    STATE(219), 1,
+      sym_type_cons,
+    STATE(220), 1,
+      sym_type_rec,
+    STATE(221), 1,
+      sym_type_union,
+    STATE(222), 1,
+      sym_type_tuple,
+    STATE(413), 1,
+      sym__type,
+  [8616] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(491), 1,
+      anon_sym_COMMA,
+    ACTIONS(705), 1,
+      anon_sym_RPAREN,
+  [8626] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(707), 1,
+      anon_sym_COMMA,
+    ACTIONS(709), 1,
+      anon_sym_RBRACK,
+  [8636] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(211), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(213), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
+    ACTIONS(215), 1,
       anon_sym_LBRACK,
-    ACTIONS(194), 1,
-      sym_var,
-    ACTIONS(196), 2,
-      sym_int,
-      sym_sym,
-    STATE(125), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1456] = 11,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(417), 1,
+      sym__type,
+  [8670] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(711), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [8678] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(713), 1,
+      sym_var,
+    ACTIONS(717), 1,
+      anon_sym_GT,
+    ACTIONS(715), 4,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
       anon_sym_LBRACK,
-    ACTIONS(198), 1,
-      sym_var,
-    ACTIONS(200), 2,
-      sym_int,
-      sym_sym,
-    STATE(116), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1501] = 11,
+  [8694] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(711), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [8702] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(719), 1,
+      anon_sym_RBRACE,
+    ACTIONS(721), 1,
+      anon_sym_COMMA,
+  [8712] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
       anon_sym_LBRACE,
-    ACTIONS(134), 1,
+    ACTIONS(211), 1,
       sym_cons_name,
-    ACTIONS(136), 1,
+    ACTIONS(213), 1,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
-      anon_sym_BSLASH,
-    ACTIONS(140), 1,
+    ACTIONS(215), 1,
       anon_sym_LBRACK,
-    ACTIONS(202), 1,
-      sym_var,
-    ACTIONS(204), 2,
-      sym_int,
-      sym_sym,
-    STATE(198), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1546] = 11,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(421), 1,
+      sym__type,
+  [8746] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(591), 1,
+      sym_var,
+    ACTIONS(723), 1,
+      anon_sym_GT,
+    ACTIONS(593), 4,
       anon_sym_LBRACE,
-    ACTIONS(134), 1,
       sym_cons_name,
-    ACTIONS(136), 1,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
-      anon_sym_BSLASH,
-    ACTIONS(140), 1,
       anon_sym_LBRACK,
-    ACTIONS(206), 1,
-      sym_var,
-    ACTIONS(208), 2,
-      sym_int,
-      sym_sym,
-    STATE(195), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1591] = 11,
+  [8762] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(134), 1,
+    ACTIONS(597), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [8772] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(725), 1,
+      anon_sym_COMMA2,
+    ACTIONS(727), 1,
+      anon_sym_GT,
+  [8782] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(617), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [8792] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(619), 1,
+      sym_cons_name,
+    ACTIONS(729), 1,
+      anon_sym_RBRACK,
+  [8802] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(623), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [8812] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(731), 1,
+      anon_sym_COMMA,
+    ACTIONS(733), 1,
+      anon_sym_RBRACK,
+  [8822] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(735), 2,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+  [8830] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(737), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [8838] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(739), 1,
       sym_cons_name,
-    ACTIONS(136), 1,
+    ACTIONS(741), 1,
+      anon_sym_RBRACK,
+  [8848] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(737), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [8856] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(529), 5,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
       anon_sym_BSLASH,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(210), 1,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [8867] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(533), 1,
       sym_var,
-    ACTIONS(212), 2,
-      sym_int,
+    ACTIONS(743), 1,
+      anon_sym_RBRACE,
+  [8877] = 6,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(75), 1,
       sym_sym,
-    STATE(175), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1636] = 11,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(745), 1,
+      anon_sym_RBRACE,
+    ACTIONS(747), 1,
+      anon_sym_COMMA,
+  [8896] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(134), 1,
-      sym_cons_name,
-    ACTIONS(136), 1,
+    ACTIONS(541), 5,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
       anon_sym_BSLASH,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(214), 1,
-      sym_var,
-    ACTIONS(216), 2,
-      sym_int,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [8907] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(237), 1,
       sym_sym,
-    STATE(181), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1681] = 11,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(545), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [8924] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(109), 1,
       anon_sym_LBRACE,
-    ACTIONS(134), 1,
+    ACTIONS(111), 1,
       sym_cons_name,
-    ACTIONS(136), 1,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
+    ACTIONS(117), 1,
       anon_sym_BSLASH,
-    ACTIONS(140), 1,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
       anon_sym_LBRACK,
-    ACTIONS(218), 1,
-      sym_var,
-    ACTIONS(220), 2,
-      sym_int,
-      sym_sym,
-    STATE(199), 11,
-      sym__expr,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
       sym_str,
+    STATE(95), 1,
       sym_app,
+    STATE(96), 1,
       sym_iapp,
+    STATE(97), 1,
       sym_lam,
+    STATE(98), 1,
       sym_rec,
+    STATE(99), 1,
       sym_prop,
+    STATE(100), 1,
       sym_cons,
+    STATE(101), 1,
       sym_when,
+    STATE(102), 1,
       sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
       sym_block,
-  [1726] = 11,
+    STATE(105), 1,
+      sym_with,
+    STATE(432), 1,
+      sym__expr,
+  [9000] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(136), 1,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
       anon_sym_LPAREN,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(146), 1,
-      sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(545), 2,
       anon_sym_BSLASH,
-    ACTIONS(222), 1,
-      sym_var,
-    ACTIONS(224), 2,
-      sym_int,
-      sym_sym,
-    STATE(162), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1771] = 11,
+      anon_sym_LF,
+  [9017] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(136), 1,
+    ACTIONS(749), 1,
+      anon_sym_DASH_GT,
+  [9024] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(751), 1,
+      anon_sym_DASH_GT,
+  [9031] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(431), 1,
       anon_sym_LPAREN,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(146), 1,
-      sym_cons_name,
-    ACTIONS(148), 1,
-      anon_sym_BSLASH,
-    ACTIONS(226), 1,
+    ACTIONS(753), 1,
       sym_var,
-    ACTIONS(228), 2,
-      sym_int,
-      sym_sym,
-    STATE(164), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1816] = 11,
+    STATE(436), 1,
+      sym_tuple,
+  [9044] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(134), 1,
-      sym_cons_name,
-    ACTIONS(136), 1,
+    ACTIONS(565), 5,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
       anon_sym_BSLASH,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(230), 1,
-      sym_var,
-    ACTIONS(232), 2,
-      sym_int,
-      sym_sym,
-    STATE(176), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1861] = 11,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [9055] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(134), 1,
-      sym_cons_name,
-    ACTIONS(136), 1,
+    ACTIONS(573), 5,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
       anon_sym_BSLASH,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(234), 1,
-      sym_var,
-    ACTIONS(236), 2,
-      sym_int,
-      sym_sym,
-    STATE(178), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1906] = 11,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [9066] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
+    ACTIONS(755), 1,
+      anon_sym_RPAREN,
+    ACTIONS(439), 3,
+      sym_var,
       anon_sym_when,
-    ACTIONS(130), 1,
+      anon_sym_with,
+    ACTIONS(441), 8,
+      sym_int,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
       anon_sym_LBRACE,
-    ACTIONS(134), 1,
       sym_cons_name,
-    ACTIONS(136), 1,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(138), 1,
       anon_sym_BSLASH,
-    ACTIONS(140), 1,
       anon_sym_LBRACK,
-    ACTIONS(238), 1,
-      sym_var,
-    ACTIONS(240), 2,
-      sym_int,
+  [9088] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(573), 5,
       sym_sym,
-    STATE(179), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1951] = 11,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [9099] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
+    ACTIONS(757), 3,
+      sym_lit_str,
       anon_sym_BQUOTE,
-    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
-    ACTIONS(15), 1,
+  [9108] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(761), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(759), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(242), 1,
-      sym_var,
-    ACTIONS(244), 2,
-      sym_int,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [9128] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
       sym_sym,
-    STATE(123), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [1996] = 11,
+    ACTIONS(763), 1,
+      anon_sym_is,
+  [9144] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(21), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(31), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
       sym_cons_name,
-    ACTIONS(33), 1,
+    ACTIONS(767), 1,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
+    ACTIONS(769), 1,
       anon_sym_when,
-    ACTIONS(246), 1,
-      sym_var,
-    ACTIONS(248), 2,
-      sym_int,
-      sym_sym,
-    STATE(78), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2041] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(441), 1,
+      sym__expr,
+  [9220] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(21), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(31), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
       sym_cons_name,
-    ACTIONS(33), 1,
+    ACTIONS(767), 1,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
+    ACTIONS(769), 1,
       anon_sym_when,
-    ACTIONS(250), 1,
-      sym_var,
-    ACTIONS(252), 2,
-      sym_int,
-      sym_sym,
-    STATE(79), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2086] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(442), 1,
+      sym__expr,
+  [9296] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(146), 1,
-      sym_cons_name,
-    ACTIONS(148), 1,
-      anon_sym_BSLASH,
-    ACTIONS(254), 1,
-      sym_var,
-    ACTIONS(256), 2,
-      sym_int,
-      sym_sym,
-    STATE(166), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [2131] = 11,
+    ACTIONS(771), 1,
+      anon_sym_DASH_GT,
+  [9303] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
+    ACTIONS(773), 1,
+      anon_sym_DASH_GT,
+  [9310] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(140), 1,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(146), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(159), 1,
+      anon_sym_else,
+    ACTIONS(673), 1,
       sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-    ACTIONS(258), 1,
-      sym_var,
-    ACTIONS(260), 2,
-      sym_int,
-      sym_sym,
-    STATE(167), 11,
-      sym__expr,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2176] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(46), 1,
+      sym__expr,
+    STATE(47), 1,
+      sym_tuple,
+    ACTIONS(49), 10,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [9395] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(775), 1,
+      sym_var,
+    ACTIONS(777), 1,
+      anon_sym_DASH_GT,
+    STATE(447), 1,
+      aux_sym_lam_repeat1,
+  [9408] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
     ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
+    ACTIONS(63), 1,
       anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(262), 1,
-      sym_var,
-    ACTIONS(264), 2,
-      sym_int,
-      sym_sym,
-    STATE(212), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2221] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(448), 1,
+      sym__expr,
+  [9484] = 7,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(783), 1,
+      anon_sym_SEMI,
+    ACTIONS(785), 1,
+      anon_sym_else,
+    ACTIONS(779), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [9511] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(787), 1,
+      anon_sym_COMMA,
+    ACTIONS(789), 1,
+      anon_sym_RPAREN,
+  [9521] = 7,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(791), 1,
+      anon_sym_SEMI,
+    ACTIONS(793), 1,
+      anon_sym_else,
+    ACTIONS(779), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [9548] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
     ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
+    ACTIONS(677), 1,
       anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(266), 1,
-      sym_var,
-    ACTIONS(268), 2,
-      sym_int,
-      sym_sym,
-    STATE(185), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2266] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(456), 1,
+      sym__expr,
+  [9624] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
-      sym_cons_name,
     ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
+    ACTIONS(677), 1,
       anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(270), 1,
-      sym_var,
-    ACTIONS(272), 2,
-      sym_int,
-      sym_sym,
-    STATE(101), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2311] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(457), 1,
+      sym__expr,
+  [9700] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(795), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [9708] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(795), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [9716] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(797), 1,
+      sym_var,
+    ACTIONS(799), 1,
+      anon_sym_RBRACE,
+  [9726] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(801), 1,
+      anon_sym_RBRACE,
+    ACTIONS(803), 1,
+      anon_sym_COMMA,
+  [9736] = 11,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(207), 1,
+      sym_var,
+    ACTIONS(209), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(211), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(213), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
-      anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
+    ACTIONS(215), 1,
       anon_sym_LBRACK,
-    ACTIONS(274), 1,
+    STATE(154), 1,
+      sym_type_cons,
+    STATE(155), 1,
+      sym_type_rec,
+    STATE(156), 1,
+      sym_type_union,
+    STATE(157), 1,
+      sym_type_tuple,
+    STATE(461), 1,
+      sym__type,
+  [9770] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(591), 1,
       sym_var,
-    ACTIONS(276), 2,
-      sym_int,
-      sym_sym,
-    STATE(188), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [2356] = 11,
+    ACTIONS(805), 1,
+      anon_sym_GT,
+    ACTIONS(593), 4,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      anon_sym_LPAREN,
+      anon_sym_LBRACK,
+  [9786] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(597), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [9794] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(807), 1,
+      anon_sym_COMMA2,
+    ACTIONS(809), 1,
+      anon_sym_GT,
+  [9804] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(617), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [9812] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(619), 1,
+      sym_cons_name,
+    ACTIONS(811), 1,
+      anon_sym_RBRACK,
+  [9822] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(623), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [9830] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(813), 1,
+      anon_sym_COMMA,
+    ACTIONS(815), 1,
+      anon_sym_RBRACK,
+  [9840] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(817), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [9848] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(689), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [9858] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(691), 1,
+      sym_var,
+    ACTIONS(819), 1,
+      anon_sym_RBRACE,
+  [9868] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(821), 1,
+      anon_sym_RBRACE,
+    ACTIONS(823), 1,
+      anon_sym_COMMA,
+  [9878] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(711), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [9888] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(713), 1,
+      sym_var,
+    ACTIONS(825), 1,
+      anon_sym_GT,
+    ACTIONS(715), 4,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+      anon_sym_LBRACK,
+  [9904] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(711), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [9914] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(737), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [9924] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(739), 1,
+      sym_cons_name,
+    ACTIONS(827), 1,
+      anon_sym_RBRACK,
+  [9934] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(737), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [9944] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(829), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [9952] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(653), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [9963] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(653), 5,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
-      anon_sym_when,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(278), 1,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [9974] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(657), 1,
       sym_var,
-    ACTIONS(280), 2,
-      sym_int,
+    ACTIONS(831), 1,
+      anon_sym_RBRACE,
+  [9984] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(237), 1,
       sym_sym,
-    STATE(205), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [2401] = 11,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(661), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [10001] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
       anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(839), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(841), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(847), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
+    ACTIONS(849), 1,
       anon_sym_when,
-    ACTIONS(21), 1,
+    ACTIONS(851), 1,
       anon_sym_LBRACK,
-    ACTIONS(282), 1,
-      sym_var,
-    ACTIONS(284), 2,
-      sym_int,
-      sym_sym,
-    STATE(206), 11,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(485), 1,
       sym__expr,
+    STATE(486), 1,
       sym_str,
+    STATE(487), 1,
       sym_app,
+    STATE(488), 1,
       sym_iapp,
+    STATE(489), 1,
       sym_lam,
+    STATE(490), 1,
       sym_rec,
+    STATE(491), 1,
       sym_prop,
+    STATE(492), 1,
       sym_cons,
+    STATE(493), 1,
       sym_when,
+    STATE(494), 1,
       sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
       sym_block,
-  [2446] = 11,
+    STATE(497), 1,
+      sym_with,
+  [10077] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
       anon_sym_BQUOTE,
-    ACTIONS(11), 1,
+    ACTIONS(839), 1,
       anon_sym_LBRACE,
-    ACTIONS(13), 1,
+    ACTIONS(841), 1,
       sym_cons_name,
-    ACTIONS(15), 1,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
       anon_sym_LPAREN,
-    ACTIONS(17), 1,
+    ACTIONS(847), 1,
       anon_sym_BSLASH,
-    ACTIONS(19), 1,
+    ACTIONS(849), 1,
       anon_sym_when,
-    ACTIONS(21), 1,
+    ACTIONS(851), 1,
       anon_sym_LBRACK,
-    ACTIONS(286), 1,
-      sym_var,
-    ACTIONS(288), 2,
-      sym_int,
-      sym_sym,
-    STATE(207), 11,
-      sym__expr,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
       sym_str,
+    STATE(487), 1,
       sym_app,
+    STATE(488), 1,
       sym_iapp,
+    STATE(489), 1,
       sym_lam,
+    STATE(490), 1,
       sym_rec,
+    STATE(491), 1,
       sym_prop,
+    STATE(492), 1,
       sym_cons,
+    STATE(493), 1,
       sym_when,
+    STATE(494), 1,
       sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
       sym_block,
-  [2491] = 11,
+    STATE(497), 1,
+      sym_with,
+    STATE(498), 1,
+      sym__expr,
+  [10153] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      sym_cons_name,
-    ACTIONS(33), 1,
-      anon_sym_BSLASH,
-    ACTIONS(35), 1,
-      anon_sym_when,
-    ACTIONS(290), 1,
-      sym_var,
-    ACTIONS(292), 2,
-      sym_int,
-      sym_sym,
-    STATE(92), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [2536] = 11,
+    ACTIONS(855), 1,
+      anon_sym_DASH_GT,
+  [10160] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(15), 1,
+    ACTIONS(857), 1,
+      anon_sym_DASH_GT,
+  [10167] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(685), 5,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      sym_cons_name,
-    ACTIONS(33), 1,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
-      anon_sym_when,
-    ACTIONS(270), 1,
-      sym_var,
-    ACTIONS(272), 2,
-      sym_int,
-      sym_sym,
-    STATE(101), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [2581] = 11,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [10178] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(21), 1,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(31), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(765), 1,
       sym_cons_name,
-    ACTIONS(33), 1,
+    ACTIONS(767), 1,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
+    ACTIONS(769), 1,
       anon_sym_when,
-    ACTIONS(294), 1,
-      sym_var,
-    ACTIONS(296), 2,
-      sym_int,
-      sym_sym,
-    STATE(94), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2626] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(46), 1,
+      sym__expr,
+    STATE(47), 1,
+      sym_tuple,
+    ACTIONS(159), 2,
+      anon_sym_is,
+      anon_sym_else,
+    ACTIONS(49), 4,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+  [10258] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      sym_cons_name,
-    ACTIONS(33), 1,
-      anon_sym_BSLASH,
-    ACTIONS(35), 1,
-      anon_sym_when,
-    ACTIONS(298), 1,
+    ACTIONS(859), 1,
       sym_var,
-    ACTIONS(300), 2,
-      sym_int,
-      sym_sym,
-    STATE(95), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [2671] = 11,
+    ACTIONS(861), 1,
+      anon_sym_DASH_GT,
+    STATE(503), 1,
+      aux_sym_lam_repeat1,
+  [10271] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(21), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(31), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
       sym_cons_name,
-    ACTIONS(33), 1,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
+    ACTIONS(63), 1,
       anon_sym_when,
-    ACTIONS(302), 1,
-      sym_var,
-    ACTIONS(304), 2,
-      sym_int,
-      sym_sym,
-    STATE(97), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2716] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(504), 1,
+      sym__expr,
+  [10347] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(15), 1,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      sym_cons_name,
-    ACTIONS(33), 1,
-      anon_sym_BSLASH,
-    ACTIONS(35), 1,
-      anon_sym_when,
-    ACTIONS(306), 1,
-      sym_var,
-    ACTIONS(308), 2,
-      sym_int,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(863), 1,
       sym_sym,
-    STATE(99), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [2761] = 11,
+    ACTIONS(865), 1,
+      anon_sym_is,
+    ACTIONS(867), 1,
+      anon_sym_SEMI,
+    ACTIONS(869), 1,
+      anon_sym_else,
+  [10369] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(9), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(11), 1,
-      anon_sym_LBRACE,
-    ACTIONS(15), 1,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(21), 1,
-      anon_sym_LBRACK,
-    ACTIONS(31), 1,
-      sym_cons_name,
-    ACTIONS(33), 1,
-      anon_sym_BSLASH,
-    ACTIONS(35), 1,
-      anon_sym_when,
-    ACTIONS(310), 1,
-      sym_var,
-    ACTIONS(312), 2,
-      sym_int,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(863), 1,
       sym_sym,
-    STATE(100), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [2806] = 11,
+    ACTIONS(865), 1,
+      anon_sym_is,
+    ACTIONS(871), 1,
+      anon_sym_SEMI,
+    ACTIONS(873), 1,
+      anon_sym_else,
+  [10391] = 25,
     ACTIONS(3), 1,
       sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
     ACTIONS(9), 1,
       anon_sym_BQUOTE,
     ACTIONS(11), 1,
       anon_sym_LBRACE,
     ACTIONS(15), 1,
-      anon_sym_LPAREN,
-    ACTIONS(21), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(31), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
       sym_cons_name,
-    ACTIONS(33), 1,
+    ACTIONS(767), 1,
       anon_sym_BSLASH,
-    ACTIONS(35), 1,
+    ACTIONS(769), 1,
       anon_sym_when,
-    ACTIONS(314), 1,
-      sym_var,
-    ACTIONS(316), 2,
-      sym_int,
-      sym_sym,
-    STATE(102), 11,
-      sym__expr,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2851] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(510), 1,
+      sym__expr,
+  [10467] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(140), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(146), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
       sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(767), 1,
       anon_sym_BSLASH,
-    ACTIONS(318), 1,
-      sym_var,
-    ACTIONS(320), 2,
-      sym_int,
-      sym_sym,
-    STATE(168), 11,
-      sym__expr,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2896] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(511), 1,
+      sym__expr,
+  [10543] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(126), 1,
-      sym_var,
-    ACTIONS(130), 1,
+    ACTIONS(875), 1,
+      anon_sym_COMMA,
+    ACTIONS(877), 1,
+      anon_sym_DASH_GT,
+  [10553] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(140), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(146), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
       sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-    ACTIONS(128), 2,
-      sym_int,
-      sym_sym,
-    STATE(152), 11,
-      sym__expr,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2941] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(514), 1,
+      sym__expr,
+  [10629] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
+    ACTIONS(879), 1,
+      sym_var,
+  [10636] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(881), 1,
+      anon_sym_is,
+  [10652] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(140), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(146), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
       sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-    ACTIONS(322), 1,
-      sym_var,
-    ACTIONS(324), 2,
-      sym_int,
-      sym_sym,
-    STATE(169), 11,
-      sym__expr,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [2986] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(135), 1,
+      sym__expr,
+  [10728] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
+    ACTIONS(885), 1,
+      sym_cons_name,
+    ACTIONS(887), 1,
+      anon_sym_else,
+    ACTIONS(883), 9,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_RBRACK,
+  [10749] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(146), 1,
+    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
       anon_sym_BSLASH,
-    ACTIONS(326), 1,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(328), 2,
-      sym_int,
-      sym_sym,
-    STATE(170), 11,
-      sym__expr,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [3031] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(518), 1,
+      sym__expr,
+  [10825] = 26,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(146), 1,
+    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
       anon_sym_BSLASH,
-    ACTIONS(330), 1,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(332), 2,
-      sym_int,
-      sym_sym,
-    STATE(171), 11,
-      sym__expr,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(889), 1,
+      anon_sym_RPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [3076] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(264), 1,
+      sym__expr,
+  [10904] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
+    ACTIONS(407), 1,
+      anon_sym_DASH_GT,
+  [10911] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(885), 1,
+      sym_cons_name,
+    ACTIONS(891), 1,
+      anon_sym_else,
+    ACTIONS(883), 9,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_DOT,
+      anon_sym_RBRACK,
+  [10932] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    ACTIONS(132), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(146), 1,
+    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
       anon_sym_BSLASH,
-    ACTIONS(334), 1,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(336), 2,
-      sym_int,
-      sym_sym,
-    STATE(172), 11,
-      sym__expr,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
       sym_str,
+    STATE(17), 1,
       sym_app,
+    STATE(18), 1,
       sym_iapp,
+    STATE(19), 1,
       sym_lam,
+    STATE(20), 1,
       sym_rec,
+    STATE(21), 1,
       sym_prop,
+    STATE(22), 1,
       sym_cons,
+    STATE(23), 1,
       sym_when,
+    STATE(24), 1,
       sym_list,
+    STATE(32), 1,
       sym_block,
-  [3121] = 11,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(521), 1,
+      sym__expr,
+  [11008] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(49), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(136), 1,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(146), 1,
-      sym_cons_name,
-    ACTIONS(148), 1,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(893), 1,
+      anon_sym_SEMI,
+    ACTIONS(895), 1,
+      anon_sym_else,
+    ACTIONS(883), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [11035] = 7,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(897), 1,
+      anon_sym_SEMI,
+    ACTIONS(899), 1,
+      anon_sym_else,
+    ACTIONS(883), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [11062] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(901), 2,
       anon_sym_BSLASH,
-    ACTIONS(338), 1,
+      anon_sym_LF,
+  [11070] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(689), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [11078] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(691), 1,
       sym_var,
-    ACTIONS(340), 2,
-      sym_int,
-      sym_sym,
-    STATE(173), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [3166] = 11,
+    ACTIONS(903), 1,
+      anon_sym_RBRACE,
+  [11088] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(905), 1,
+      anon_sym_RBRACE,
+    ACTIONS(907), 1,
+      anon_sym_COMMA,
+  [11098] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(69), 1,
-      anon_sym_when,
-    ACTIONS(130), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(132), 1,
-      anon_sym_LBRACE,
-    ACTIONS(134), 1,
-      sym_cons_name,
-    ACTIONS(136), 1,
-      anon_sym_LPAREN,
-    ACTIONS(138), 1,
-      anon_sym_BSLASH,
-    ACTIONS(140), 1,
-      anon_sym_LBRACK,
-    ACTIONS(342), 1,
-      sym_var,
-    ACTIONS(344), 2,
-      sym_int,
-      sym_sym,
-    STATE(196), 11,
-      sym__expr,
-      sym_str,
-      sym_app,
-      sym_iapp,
-      sym_lam,
-      sym_rec,
-      sym_prop,
-      sym_cons,
-      sym_when,
-      sym_list,
-      sym_block,
-  [3211] = 7,
+    ACTIONS(711), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [11106] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(346), 1,
+    ACTIONS(713), 1,
       sym_var,
-    ACTIONS(351), 1,
-      anon_sym_when,
-    ACTIONS(353), 1,
-      anon_sym_import,
-    STATE(71), 1,
-      aux_sym__inner_block_repeat1,
-    STATE(312), 5,
-      sym_assign,
-      sym_bind,
-      sym_annot,
-      sym_import,
-      sym__decl,
-    ACTIONS(349), 8,
-      sym_int,
-      anon_sym_BQUOTE,
+    ACTIONS(909), 1,
+      anon_sym_GT,
+    ACTIONS(715), 4,
       anon_sym_LBRACE,
       sym_cons_name,
-      sym_sym,
       anon_sym_LPAREN,
-      anon_sym_BSLASH,
       anon_sym_LBRACK,
-  [3244] = 2,
+  [11122] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(356), 11,
-      ts_builtin_sym_end,
+    ACTIONS(711), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [11130] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(737), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [11138] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(739), 1,
+      sym_cons_name,
+    ACTIONS(911), 1,
+      anon_sym_RBRACK,
+  [11148] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(737), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [11156] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(795), 4,
       anon_sym_RBRACE,
-      sym_sym,
-      anon_sym_LPAREN,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-      anon_sym_DOT,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
       anon_sym_RBRACK,
-  [3261] = 4,
+  [11166] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(362), 1,
+    ACTIONS(795), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
       anon_sym_RBRACK,
-    ACTIONS(358), 2,
+  [11176] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(797), 1,
       sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
-      sym_int,
-      anon_sym_BQUOTE,
-      anon_sym_LBRACE,
-      sym_cons_name,
-      sym_sym,
-      anon_sym_LPAREN,
-      anon_sym_BSLASH,
-      anon_sym_LBRACK,
-  [3282] = 4,
+    ACTIONS(913), 1,
+      anon_sym_RBRACE,
+  [11186] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(364), 1,
+    ACTIONS(817), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
       anon_sym_RPAREN,
-    ACTIONS(358), 2,
-      sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
-      sym_int,
-      anon_sym_BQUOTE,
-      anon_sym_LBRACE,
-      sym_cons_name,
+      anon_sym_RBRACK,
+  [11196] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(829), 4,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [11206] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(759), 5,
       sym_sym,
       anon_sym_LPAREN,
       anon_sym_BSLASH,
-      anon_sym_LBRACK,
-  [3303] = 2,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [11217] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(366), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3320] = 2,
+      anon_sym_LF,
+  [11232] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(368), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3337] = 4,
+      anon_sym_LF,
+  [11247] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(370), 1,
-      anon_sym_RBRACK,
-    ACTIONS(358), 2,
+    ACTIONS(39), 1,
+      sym_lit_str,
+    ACTIONS(43), 1,
+      anon_sym_LBRACE,
+    ACTIONS(915), 1,
+      anon_sym_BQUOTE,
+    STATE(533), 1,
+      aux_sym_str_repeat1,
+  [11263] = 4,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(917), 1,
       sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
+    ACTIONS(919), 1,
+      anon_sym_RBRACE,
+    STATE(536), 1,
+      aux_sym_rec_repeat1,
+  [11276] = 24,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(159), 1,
+      anon_sym_else,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
       sym_int,
+    ACTIONS(837), 1,
       anon_sym_BQUOTE,
+    ACTIONS(839), 1,
       anon_sym_LBRACE,
+    ACTIONS(841), 1,
       sym_cons_name,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(537), 1,
+      sym__expr,
+    ACTIONS(49), 6,
       sym_sym,
       anon_sym_LPAREN,
       anon_sym_BSLASH,
-      anon_sym_LBRACK,
-  [3358] = 6,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [11354] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
-    ACTIONS(376), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(380), 1,
-      anon_sym_else,
-    ACTIONS(372), 7,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_RBRACK,
-  [3383] = 6,
+      anon_sym_LF,
+  [11369] = 33,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(5), 1,
+      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
       sym_sym,
-    ACTIONS(376), 1,
+    ACTIONS(17), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(384), 1,
-      anon_sym_else,
-    ACTIONS(382), 7,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_RBRACK,
-  [3408] = 2,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(25), 1,
+      anon_sym_import,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(25), 1,
+      sym_tuple,
+    STATE(26), 1,
+      sym_assign,
+    STATE(27), 1,
+      sym_bind,
+    STATE(28), 1,
+      sym_annot,
+    STATE(29), 1,
+      sym_import,
+    STATE(30), 1,
+      sym__decl,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(34), 1,
+      aux_sym__inner_block_repeat1,
+    STATE(538), 1,
+      sym__expr,
+    STATE(539), 1,
+      sym__inner_block,
+  [11469] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(386), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(921), 1,
+      sym_var,
+    ACTIONS(923), 1,
+      anon_sym_DASH_GT,
+    STATE(542), 1,
+      aux_sym_lam_repeat1,
+  [11482] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_DOT,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3425] = 4,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(543), 1,
+      sym__expr,
+  [11558] = 27,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(388), 1,
-      anon_sym_RBRACK,
-    ACTIONS(358), 2,
-      sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
+    ACTIONS(7), 1,
       sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
+    ACTIONS(13), 1,
       sym_cons_name,
+    ACTIONS(15), 1,
       sym_sym,
-      anon_sym_LPAREN,
+    ACTIONS(19), 1,
       anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-  [3446] = 4,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(390), 1,
-      anon_sym_RBRACK,
-    ACTIONS(358), 2,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
-      sym_int,
-      anon_sym_BQUOTE,
-      anon_sym_LBRACE,
-      sym_cons_name,
-      sym_sym,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-      anon_sym_BSLASH,
-      anon_sym_LBRACK,
-  [3467] = 4,
+    ACTIONS(925), 1,
+      anon_sym_RBRACK,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(545), 1,
+      sym__expr,
+    STATE(546), 1,
+      aux_sym_app_repeat1,
+  [11640] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(392), 1,
-      anon_sym_RPAREN,
-    ACTIONS(358), 2,
+    ACTIONS(927), 1,
       sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
-      sym_int,
-      anon_sym_BQUOTE,
-      anon_sym_LBRACE,
-      sym_cons_name,
+  [11647] = 7,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(929), 1,
       sym_sym,
+    ACTIONS(931), 1,
       anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(935), 1,
+      anon_sym_SEMI,
+    ACTIONS(937), 1,
+      anon_sym_else,
+    ACTIONS(779), 2,
       anon_sym_BSLASH,
-      anon_sym_LBRACK,
-  [3488] = 4,
+      anon_sym_LF,
+  [11670] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(394), 1,
-      anon_sym_RPAREN,
-    ACTIONS(358), 2,
-      sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
-      sym_int,
-      anon_sym_BQUOTE,
-      anon_sym_LBRACE,
-      sym_cons_name,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
       anon_sym_BSLASH,
-      anon_sym_LBRACK,
-  [3509] = 2,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [11685] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(396), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3526] = 2,
+      anon_sym_LF,
+  [11700] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(398), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3543] = 2,
+      anon_sym_LF,
+  [11715] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(400), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3560] = 2,
+      anon_sym_LF,
+  [11730] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(402), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3577] = 4,
+      anon_sym_LF,
+  [11745] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(404), 1,
-      anon_sym_RPAREN,
-    ACTIONS(358), 2,
-      sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
-      sym_int,
-      anon_sym_BQUOTE,
-      anon_sym_LBRACE,
-      sym_cons_name,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
       anon_sym_BSLASH,
-      anon_sym_LBRACK,
-  [3598] = 2,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [11760] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(406), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3615] = 2,
+      anon_sym_LF,
+  [11775] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(408), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3632] = 5,
+      anon_sym_LF,
+  [11790] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
-    ACTIONS(376), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(410), 8,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3655] = 2,
+      anon_sym_LF,
+  [11805] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(412), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3672] = 5,
+      anon_sym_LF,
+  [11820] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
-    ACTIONS(376), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(414), 8,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3695] = 5,
+      anon_sym_LF,
+  [11835] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(37), 1,
+      anon_sym_else,
+    ACTIONS(29), 6,
       sym_sym,
-    ACTIONS(376), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(416), 8,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3718] = 2,
+      anon_sym_LF,
+  [11850] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(418), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(929), 1,
       sym_sym,
+    ACTIONS(931), 1,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+    ACTIONS(933), 1,
       anon_sym_DOT,
-      anon_sym_is,
+    ACTIONS(939), 1,
       anon_sym_SEMI,
+    ACTIONS(941), 1,
       anon_sym_else,
-      anon_sym_RBRACK,
-  [3735] = 5,
+    ACTIONS(779), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [11873] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
       sym_sym,
-    ACTIONS(376), 1,
+    ACTIONS(845), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(420), 8,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3758] = 7,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(555), 1,
+      sym__expr,
+  [11949] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
       sym_sym,
-    ACTIONS(376), 1,
+    ACTIONS(845), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(422), 1,
-      anon_sym_SEMI,
-    ACTIONS(424), 1,
-      anon_sym_else,
-    ACTIONS(372), 6,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_RBRACK,
-  [3785] = 5,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(556), 1,
+      sym__expr,
+  [12025] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
-      sym_sym,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(426), 8,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(943), 1,
       anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3808] = 5,
+    ACTIONS(945), 1,
+      anon_sym_DASH_GT,
+  [12035] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
-    ACTIONS(376), 1,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(428), 8,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3831] = 4,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(559), 1,
+      sym__expr,
+  [12111] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(430), 9,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      sym_sym,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3852] = 5,
+    ACTIONS(947), 1,
+      sym_var,
+  [12118] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
-      sym_sym,
-    ACTIONS(376), 1,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(432), 8,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(949), 1,
       anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3875] = 2,
+  [12134] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(434), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_DOT,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3892] = 4,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(135), 1,
+      sym__expr,
+  [12210] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(436), 1,
+    ACTIONS(885), 1,
       sym_cons_name,
-    ACTIONS(438), 1,
+    ACTIONS(951), 1,
+      anon_sym_is,
+    ACTIONS(953), 1,
       anon_sym_else,
-    ACTIONS(382), 9,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(883), 3,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
       anon_sym_DOT,
-      anon_sym_is,
-      anon_sym_RBRACK,
-  [3913] = 2,
+  [12228] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(440), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_DOT,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3930] = 7,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(563), 1,
+      sym__expr,
+  [12304] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(885), 1,
+      sym_cons_name,
+    ACTIONS(951), 1,
+      anon_sym_is,
+    ACTIONS(955), 1,
+      anon_sym_else,
+    ACTIONS(883), 3,
       sym_sym,
-    ACTIONS(376), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
       anon_sym_DOT,
-    ACTIONS(438), 1,
-      anon_sym_else,
-    ACTIONS(442), 1,
-      anon_sym_SEMI,
-    ACTIONS(382), 6,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_RBRACK,
-  [3957] = 2,
+  [12322] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(444), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_DOT,
-      anon_sym_is,
-      anon_sym_SEMI,
-      anon_sym_else,
-      anon_sym_RBRACK,
-  [3974] = 2,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(565), 1,
+      sym__expr,
+  [12398] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(446), 11,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+    ACTIONS(79), 1,
       anon_sym_DOT,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(951), 1,
       anon_sym_is,
+    ACTIONS(957), 1,
       anon_sym_SEMI,
+    ACTIONS(959), 1,
       anon_sym_else,
-      anon_sym_RBRACK,
-  [3991] = 4,
+  [12420] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(436), 1,
-      sym_cons_name,
-    ACTIONS(448), 1,
-      anon_sym_else,
-    ACTIONS(426), 9,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
+    ACTIONS(79), 1,
       anon_sym_DOT,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(951), 1,
       anon_sym_is,
-      anon_sym_RBRACK,
-  [4012] = 3,
+    ACTIONS(961), 1,
+      anon_sym_SEMI,
+    ACTIONS(963), 1,
+      anon_sym_else,
+  [12442] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(351), 3,
+    ACTIONS(267), 1,
       sym_var,
-      anon_sym_when,
-      anon_sym_import,
-    ACTIONS(349), 8,
+    ACTIONS(965), 1,
+      anon_sym_DASH_GT,
+  [12452] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
       sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-      sym_cons_name,
+    ACTIONS(15), 1,
       sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-      anon_sym_LBRACK,
-  [4031] = 2,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(571), 1,
+      sym__expr,
+  [12528] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(450), 11,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(421), 1,
+      anon_sym_else,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(271), 7,
       ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
-      sym_sym,
-      anon_sym_LPAREN,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-      anon_sym_DOT,
-      anon_sym_is,
       anon_sym_SEMI,
-      anon_sym_else,
       anon_sym_RBRACK,
-  [4048] = 8,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(452), 1,
-      sym_var,
-    ACTIONS(454), 1,
-      anon_sym_LBRACE,
-    ACTIONS(456), 1,
-      sym_cons_name,
-    ACTIONS(458), 1,
-      anon_sym_LBRACK,
-    ACTIONS(460), 1,
-      anon_sym_GT,
-    STATE(121), 1,
-      aux_sym_type_cons_repeat1,
-    STATE(291), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4076] = 8,
+  [12553] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(454), 1,
-      anon_sym_LBRACE,
-    ACTIONS(456), 1,
-      sym_cons_name,
-    ACTIONS(458), 1,
-      anon_sym_LBRACK,
-    ACTIONS(462), 1,
-      sym_var,
-    ACTIONS(464), 1,
-      anon_sym_GT,
-    STATE(126), 1,
-      aux_sym_type_cons_repeat1,
-    STATE(277), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4104] = 8,
+    ACTIONS(967), 1,
+      anon_sym_COMMA,
+    ACTIONS(969), 1,
+      anon_sym_DASH_GT,
+  [12563] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(454), 1,
-      anon_sym_LBRACE,
-    ACTIONS(456), 1,
+    ACTIONS(971), 1,
       sym_cons_name,
-    ACTIONS(458), 1,
-      anon_sym_LBRACK,
-    ACTIONS(466), 1,
-      sym_var,
-    ACTIONS(468), 1,
-      anon_sym_GT,
-    STATE(124), 1,
-      aux_sym_type_cons_repeat1,
-    STATE(260), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4132] = 3,
+    STATE(575), 1,
+      aux_sym_when_repeat1,
+  [12573] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(358), 2,
-      sym_var,
-      anon_sym_when,
-    ACTIONS(360), 8,
+    ACTIONS(7), 1,
       sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
+    ACTIONS(13), 1,
       sym_cons_name,
+    ACTIONS(15), 1,
       sym_sym,
-      anon_sym_LPAREN,
+    ACTIONS(19), 1,
       anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-  [4150] = 5,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(576), 1,
+      sym__expr,
+  [12649] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(432), 6,
+    ACTIONS(973), 6,
       ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-      anon_sym_is,
       anon_sym_RBRACK,
-  [4171] = 5,
+  [12670] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(541), 1,
+      anon_sym_DASH_GT,
+  [12677] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(577), 1,
+      sym__expr,
+  [12753] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(420), 6,
+    ACTIONS(973), 6,
       ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-      anon_sym_is,
       anon_sym_RBRACK,
-  [4192] = 5,
+  [12774] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(414), 6,
+    ACTIONS(975), 1,
+      sym_cons_name,
+    ACTIONS(977), 1,
+      anon_sym_else,
+    ACTIONS(973), 9,
       ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-      anon_sym_is,
+      anon_sym_DOT,
       anon_sym_RBRACK,
-  [4213] = 7,
+  [12795] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(472), 1,
-      sym_var,
-    ACTIONS(475), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(478), 1,
+    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(481), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    STATE(119), 1,
-      aux_sym_type_cons_repeat1,
-    STATE(389), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4238] = 5,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(579), 1,
+      sym__expr,
+  [12871] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(416), 6,
+    ACTIONS(975), 1,
+      sym_cons_name,
+    ACTIONS(979), 1,
+      anon_sym_else,
+    ACTIONS(973), 9,
       ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-      anon_sym_is,
+      anon_sym_DOT,
       anon_sym_RBRACK,
-  [4259] = 7,
+  [12892] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(454), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(456), 1,
+    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(458), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(484), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    STATE(119), 1,
-      aux_sym_type_cons_repeat1,
-    STATE(318), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4284] = 5,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(581), 1,
+      sym__expr,
+  [12968] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(426), 6,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_RBRACK,
-  [4305] = 5,
+    ACTIONS(795), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [12976] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(410), 6,
-      ts_builtin_sym_end,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RPAREN,
-      anon_sym_is,
-      anon_sym_RBRACK,
-  [4326] = 7,
+    ACTIONS(795), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [12984] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(454), 1,
-      anon_sym_LBRACE,
-    ACTIONS(456), 1,
-      sym_cons_name,
-    ACTIONS(458), 1,
-      anon_sym_LBRACK,
-    ACTIONS(486), 1,
+    ACTIONS(797), 1,
       sym_var,
-    STATE(119), 1,
-      aux_sym_type_cons_repeat1,
-    STATE(266), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4351] = 5,
+    ACTIONS(981), 1,
+      anon_sym_RBRACE,
+  [12994] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(428), 6,
-      ts_builtin_sym_end,
+    ACTIONS(817), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [13002] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(829), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [13010] = 2,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(901), 4,
       anon_sym_RBRACE,
       anon_sym_COMMA,
       anon_sym_RPAREN,
-      anon_sym_is,
       anon_sym_RBRACK,
-  [4372] = 7,
+  [13020] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(454), 1,
-      anon_sym_LBRACE,
-    ACTIONS(456), 1,
-      sym_cons_name,
-    ACTIONS(458), 1,
-      anon_sym_LBRACK,
-    ACTIONS(488), 1,
-      sym_var,
-    STATE(119), 1,
-      aux_sym_type_cons_repeat1,
-    STATE(280), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4397] = 6,
+    ACTIONS(129), 1,
+      anon_sym_else,
+    ACTIONS(127), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [13035] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(490), 1,
-      sym_var,
-    ACTIONS(492), 1,
+    ACTIONS(131), 1,
+      sym_lit_str,
+    ACTIONS(135), 1,
       anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    STATE(340), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4419] = 6,
+    ACTIONS(983), 1,
+      anon_sym_BQUOTE,
+  [13048] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(498), 1,
-      sym_var,
-    STATE(284), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4441] = 6,
+    ACTIONS(985), 1,
+      anon_sym_COLON,
+  [13055] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(141), 1,
+      anon_sym_else,
+    ACTIONS(139), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [13070] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(500), 1,
+    ACTIONS(987), 1,
       sym_var,
-    ACTIONS(502), 1,
-      anon_sym_LBRACE,
-    ACTIONS(504), 1,
-      sym_cons_name,
-    ACTIONS(506), 1,
-      anon_sym_LBRACK,
-    STATE(296), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4463] = 6,
+  [13077] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(510), 1,
+    ACTIONS(147), 1,
+      anon_sym_else,
+    ACTIONS(145), 6,
       sym_sym,
-    ACTIONS(512), 1,
-      anon_sym_COLON,
-    ACTIONS(514), 1,
-      anon_sym_EQ,
-    ACTIONS(516), 1,
-      anon_sym_LT_DASH,
-    ACTIONS(508), 4,
-      ts_builtin_sym_end,
       anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [13092] = 7,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(73), 1,
       anon_sym_RPAREN,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-  [4485] = 6,
+    ACTIONS(149), 1,
+      anon_sym_COMMA,
+    STATE(586), 1,
+      aux_sym_tuple_repeat1,
+  [13114] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(518), 1,
-      sym_var,
-    STATE(321), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4507] = 6,
+    ACTIONS(989), 1,
+      anon_sym_RPAREN,
+  [13121] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(520), 1,
-      sym_var,
-    STATE(395), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4529] = 6,
+    ACTIONS(991), 1,
+      anon_sym_COMMA,
+    ACTIONS(993), 1,
+      anon_sym_DASH_GT,
+  [13131] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
       anon_sym_LBRACE,
-    ACTIONS(494), 1,
+    ACTIONS(841), 1,
       sym_cons_name,
-    ACTIONS(496), 1,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
       anon_sym_LBRACK,
-    ACTIONS(522), 1,
-      sym_var,
-    STATE(282), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4551] = 6,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(590), 1,
+      sym__expr,
+  [13207] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(524), 1,
+    ACTIONS(995), 1,
       sym_var,
-    STATE(355), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4573] = 6,
+  [13214] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(526), 1,
-      sym_var,
-    STATE(315), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4595] = 6,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(997), 1,
+      anon_sym_is,
+  [13230] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(528), 1,
-      sym_var,
-    STATE(264), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4617] = 6,
+    ACTIONS(171), 1,
+      anon_sym_else,
+    ACTIONS(169), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [13245] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(530), 1,
-      sym_var,
-    STATE(293), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4639] = 6,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(999), 1,
+      anon_sym_COMMA,
+    ACTIONS(1001), 1,
+      anon_sym_RBRACK,
+  [13264] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(494), 1,
+    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(496), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(532), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    STATE(268), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4661] = 6,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(595), 1,
+      sym__expr,
+  [13340] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(181), 1,
+      anon_sym_DOT,
+    ACTIONS(1003), 1,
+      anon_sym_LPAREN,
+    STATE(597), 1,
+      sym_block,
+    STATE(598), 1,
+      aux_sym_with_repeat1,
+  [13356] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
       anon_sym_LBRACE,
-    ACTIONS(494), 1,
+    ACTIONS(841), 1,
       sym_cons_name,
-    ACTIONS(496), 1,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
       anon_sym_LBRACK,
-    ACTIONS(534), 1,
-      sym_var,
-    STATE(271), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4683] = 6,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(599), 1,
+      sym__expr,
+  [13432] = 27,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(494), 1,
+    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(496), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-    ACTIONS(536), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    STATE(278), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4705] = 6,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(1005), 1,
+      anon_sym_RPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(601), 1,
+      sym__expr,
+    STATE(602), 1,
+      aux_sym_app_repeat1,
+  [13514] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
-      sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(538), 1,
+    ACTIONS(1007), 1,
       sym_var,
-    STATE(261), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4727] = 6,
+  [13521] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(492), 1,
-      anon_sym_LBRACE,
-    ACTIONS(494), 1,
+    ACTIONS(885), 1,
       sym_cons_name,
-    ACTIONS(496), 1,
-      anon_sym_LBRACK,
-    ACTIONS(540), 1,
-      sym_var,
-    STATE(279), 4,
-      sym__type,
-      sym_type_cons,
-      sym_type_rec,
-      sym_type_union,
-  [4749] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(366), 1,
-      anon_sym_LF,
-    ACTIONS(542), 6,
+    ACTIONS(1009), 1,
+      anon_sym_else,
+    ACTIONS(883), 5,
       sym_sym,
       anon_sym_LPAREN,
       anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4764] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(432), 1,
       anon_sym_LF,
-    ACTIONS(544), 1,
-      sym_sym,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(548), 3,
-      anon_sym_BSLASH,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4785] = 3,
-    ACTIONS(53), 1,
+  [13538] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(434), 1,
-      anon_sym_LF,
-    ACTIONS(552), 6,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
       sym_sym,
+    ACTIONS(115), 1,
       anon_sym_LPAREN,
+    ACTIONS(117), 1,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4800] = 3,
-    ACTIONS(53), 1,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(605), 1,
+      sym__expr,
+  [13614] = 4,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(444), 1,
-      anon_sym_LF,
-    ACTIONS(554), 6,
-      sym_sym,
-      anon_sym_LPAREN,
-      anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
+    ACTIONS(885), 1,
+      sym_cons_name,
+    ACTIONS(1011), 1,
       anon_sym_else,
-  [4815] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(386), 1,
-      anon_sym_LF,
-    ACTIONS(556), 6,
+    ACTIONS(883), 5,
       sym_sym,
       anon_sym_LPAREN,
       anon_sym_BSLASH,
       anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4830] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(398), 1,
       anon_sym_LF,
-    ACTIONS(558), 6,
-      sym_sym,
-      anon_sym_LPAREN,
-      anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4845] = 3,
-    ACTIONS(53), 1,
+  [13631] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(406), 1,
-      anon_sym_LF,
-    ACTIONS(560), 6,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
       sym_sym,
+    ACTIONS(115), 1,
       anon_sym_LPAREN,
+    ACTIONS(117), 1,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4860] = 3,
-    ACTIONS(53), 1,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(607), 1,
+      sym__expr,
+  [13707] = 7,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(408), 1,
-      anon_sym_LF,
-    ACTIONS(562), 6,
+    ACTIONS(929), 1,
       sym_sym,
+    ACTIONS(931), 1,
       anon_sym_LPAREN,
-      anon_sym_BSLASH,
+    ACTIONS(933), 1,
       anon_sym_DOT,
+    ACTIONS(1013), 1,
       anon_sym_SEMI,
+    ACTIONS(1015), 1,
       anon_sym_else,
-  [4875] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(396), 1,
-      anon_sym_LF,
-    ACTIONS(564), 6,
-      sym_sym,
-      anon_sym_LPAREN,
+    ACTIONS(883), 2,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4890] = 5,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(430), 1,
       anon_sym_LF,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(566), 4,
-      sym_sym,
-      anon_sym_BSLASH,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4909] = 3,
-    ACTIONS(53), 1,
+  [13730] = 7,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(440), 1,
-      anon_sym_LF,
-    ACTIONS(568), 6,
+    ACTIONS(929), 1,
       sym_sym,
+    ACTIONS(931), 1,
       anon_sym_LPAREN,
-      anon_sym_BSLASH,
+    ACTIONS(933), 1,
       anon_sym_DOT,
+    ACTIONS(1017), 1,
       anon_sym_SEMI,
+    ACTIONS(1019), 1,
       anon_sym_else,
-  [4924] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(446), 1,
-      anon_sym_LF,
-    ACTIONS(570), 6,
-      sym_sym,
-      anon_sym_LPAREN,
+    ACTIONS(883), 2,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4939] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(402), 1,
       anon_sym_LF,
-    ACTIONS(572), 6,
-      sym_sym,
-      anon_sym_LPAREN,
-      anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4954] = 3,
-    ACTIONS(53), 1,
+  [13753] = 3,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(368), 1,
-      anon_sym_LF,
-    ACTIONS(574), 6,
-      sym_sym,
-      anon_sym_LPAREN,
-      anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4969] = 3,
-    ACTIONS(53), 1,
+    ACTIONS(267), 1,
+      sym_var,
+    ACTIONS(1021), 1,
+      anon_sym_DASH_GT,
+  [13763] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(400), 1,
-      anon_sym_LF,
-    ACTIONS(576), 6,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [4984] = 3,
-    ACTIONS(53), 1,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(613), 1,
+      sym__expr,
+  [13839] = 6,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(450), 1,
-      anon_sym_LF,
-    ACTIONS(578), 6,
-      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-      anon_sym_BSLASH,
+    ACTIONS(79), 1,
       anon_sym_DOT,
+    ACTIONS(271), 1,
       anon_sym_SEMI,
-      anon_sym_else,
-  [4999] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(356), 1,
-      anon_sym_LF,
-    ACTIONS(580), 6,
+    ACTIONS(863), 1,
       sym_sym,
-      anon_sym_LPAREN,
-      anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
+    ACTIONS(421), 2,
+      anon_sym_is,
       anon_sym_else,
-  [5014] = 3,
-    ACTIONS(53), 1,
+  [13859] = 3,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(412), 1,
-      anon_sym_LF,
-    ACTIONS(582), 6,
-      sym_sym,
-      anon_sym_LPAREN,
-      anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [5029] = 3,
-    ACTIONS(53), 1,
+    ACTIONS(1023), 1,
+      anon_sym_COMMA,
+    ACTIONS(1025), 1,
+      anon_sym_DASH_GT,
+  [13869] = 3,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(418), 1,
-      anon_sym_LF,
-    ACTIONS(584), 6,
+    ACTIONS(1027), 1,
+      sym_cons_name,
+    STATE(617), 1,
+      aux_sym_when_repeat1,
+  [13879] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [5044] = 8,
-    ACTIONS(53), 1,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(618), 1,
+      sym__expr,
+  [13955] = 5,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(372), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
-      sym_sym,
-    ACTIONS(546), 1,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(586), 1,
-      anon_sym_BSLASH,
-    ACTIONS(588), 1,
-      anon_sym_SEMI,
-    ACTIONS(590), 1,
-      anon_sym_else,
-  [5069] = 5,
-    ACTIONS(53), 1,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(1029), 1,
+      anon_sym_is,
+  [13971] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(382), 1,
-      anon_sym_LF,
-    ACTIONS(592), 1,
-      sym_cons_name,
-    ACTIONS(596), 1,
-      anon_sym_else,
-    ACTIONS(594), 4,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-      anon_sym_DOT,
-  [5088] = 8,
-    ACTIONS(53), 1,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(619), 1,
+      sym__expr,
+  [14047] = 5,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(382), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
-      sym_sym,
-    ACTIONS(546), 1,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(594), 1,
-      anon_sym_BSLASH,
-    ACTIONS(596), 1,
-      anon_sym_else,
-    ACTIONS(598), 1,
-      anon_sym_SEMI,
-  [5113] = 5,
-    ACTIONS(53), 1,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(1029), 1,
+      anon_sym_is,
+  [14063] = 5,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(426), 1,
-      anon_sym_LF,
-    ACTIONS(592), 1,
+    ACTIONS(975), 1,
       sym_cons_name,
-    ACTIONS(602), 1,
+    ACTIONS(1029), 1,
+      anon_sym_is,
+    ACTIONS(1031), 1,
       anon_sym_else,
-    ACTIONS(600), 4,
+    ACTIONS(973), 3,
       sym_sym,
       anon_sym_LPAREN,
-      anon_sym_BSLASH,
       anon_sym_DOT,
-  [5132] = 7,
-    ACTIONS(53), 1,
+  [14081] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(372), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
-    ACTIONS(546), 1,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(604), 1,
-      anon_sym_else,
-    ACTIONS(586), 2,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-      anon_sym_SEMI,
-  [5155] = 7,
-    ACTIONS(53), 1,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(621), 1,
+      sym__expr,
+  [14157] = 5,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(382), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
-      sym_sym,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(606), 1,
+    ACTIONS(975), 1,
+      sym_cons_name,
+    ACTIONS(1029), 1,
+      anon_sym_is,
+    ACTIONS(1033), 1,
       anon_sym_else,
-    ACTIONS(594), 2,
-      anon_sym_BSLASH,
-      anon_sym_SEMI,
-  [5178] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(410), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
+    ACTIONS(973), 3,
       sym_sym,
-    ACTIONS(546), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
       anon_sym_DOT,
-    ACTIONS(608), 3,
-      anon_sym_BSLASH,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [5199] = 6,
-    ACTIONS(53), 1,
+  [14175] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(414), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
-    ACTIONS(546), 1,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(610), 3,
+    ACTIONS(61), 1,
       anon_sym_BSLASH,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [5220] = 6,
-    ACTIONS(53), 1,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(623), 1,
+      sym__expr,
+  [14251] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(416), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
-    ACTIONS(546), 1,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(612), 3,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [5241] = 6,
-    ACTIONS(53), 1,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(624), 1,
+      sym__expr,
+  [14327] = 6,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(420), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
-      sym_sym,
-    ACTIONS(546), 1,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(614), 3,
-      anon_sym_BSLASH,
-      anon_sym_SEMI,
+    ACTIONS(551), 1,
       anon_sym_else,
-  [5262] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(426), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
+    ACTIONS(781), 1,
       sym_sym,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(600), 3,
-      anon_sym_BSLASH,
+    ACTIONS(409), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
       anon_sym_SEMI,
-      anon_sym_else,
-  [5283] = 6,
-    ACTIONS(53), 1,
+      anon_sym_RBRACK,
+  [14352] = 3,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(428), 1,
-      anon_sym_LF,
-    ACTIONS(544), 1,
+    ACTIONS(411), 1,
+      sym_var,
+    ACTIONS(1035), 1,
+      anon_sym_DASH_GT,
+  [14362] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
       sym_sym,
-    ACTIONS(546), 1,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(616), 3,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
       anon_sym_BSLASH,
-      anon_sym_SEMI,
-      anon_sym_else,
-  [5304] = 6,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(626), 1,
+      sym__expr,
+  [14438] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(618), 1,
-      anon_sym_COMMA,
-    ACTIONS(620), 1,
-      anon_sym_RBRACK,
-  [5323] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(414), 1,
-      anon_sym_LF,
-    ACTIONS(546), 1,
+    ACTIONS(431), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(610), 1,
-      anon_sym_BSLASH,
-    ACTIONS(622), 1,
-      sym_sym,
-  [5342] = 6,
-    ACTIONS(53), 1,
+    ACTIONS(1037), 1,
+      sym_var,
+    STATE(628), 1,
+      sym_tuple,
+  [14451] = 2,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(426), 1,
-      anon_sym_LF,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(600), 1,
-      anon_sym_BSLASH,
-    ACTIONS(622), 1,
-      sym_sym,
-  [5361] = 6,
+    ACTIONS(1039), 1,
+      sym_cons_name,
+  [14458] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(624), 1,
+    ACTIONS(1041), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
-    ACTIONS(626), 1,
       anon_sym_COMMA,
-  [5380] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(428), 1,
-      anon_sym_LF,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(616), 1,
-      anon_sym_BSLASH,
-    ACTIONS(622), 1,
-      sym_sym,
-  [5399] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(432), 1,
-      anon_sym_LF,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(548), 1,
-      anon_sym_BSLASH,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(622), 1,
-      sym_sym,
-  [5418] = 5,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [14479] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(628), 2,
+    ACTIONS(1041), 6,
       ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
       anon_sym_RPAREN,
-  [5435] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(416), 1,
-      anon_sym_LF,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(612), 1,
-      anon_sym_BSLASH,
-    ACTIONS(622), 1,
-      sym_sym,
-  [5454] = 3,
+      anon_sym_RBRACK,
+  [14500] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(632), 1,
-      anon_sym_GT,
-    ACTIONS(630), 4,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-      sym_var,
+    ACTIONS(13), 1,
       sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-  [5467] = 6,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(388), 1,
-      anon_sym_RBRACK,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(634), 1,
-      anon_sym_COMMA,
-  [5486] = 6,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(630), 1,
+      sym__expr,
+  [14576] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(636), 1,
+    ACTIONS(1041), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
       anon_sym_COMMA,
-    ACTIONS(638), 1,
       anon_sym_RPAREN,
-  [5505] = 6,
+      anon_sym_RBRACK,
+  [14597] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
       sym_sym,
-    ACTIONS(640), 1,
-      anon_sym_RBRACE,
-    ACTIONS(642), 1,
-      anon_sym_COMMA,
-  [5524] = 6,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(631), 1,
+      sym__expr,
+  [14673] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(364), 1,
-      anon_sym_RPAREN,
-    ACTIONS(376), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(644), 1,
+    ACTIONS(1041), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
       anon_sym_COMMA,
-  [5543] = 6,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [14694] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(392), 1,
-      anon_sym_RPAREN,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(646), 1,
-      anon_sym_COMMA,
-  [5562] = 6,
+    ACTIONS(901), 2,
+      anon_sym_COMMA2,
+      anon_sym_GT,
+  [14702] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(255), 1,
+      anon_sym_else,
+    ACTIONS(253), 6,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(648), 1,
-      anon_sym_RBRACE,
-    ACTIONS(650), 1,
-      anon_sym_COMMA,
-  [5581] = 3,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [14717] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(652), 1,
-      anon_sym_GT,
-    ACTIONS(630), 4,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-      sym_var,
+    ACTIONS(13), 1,
       sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
       anon_sym_LBRACK,
-  [5594] = 3,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(632), 1,
+      sym__expr,
+  [14793] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(654), 1,
-      anon_sym_GT,
-    ACTIONS(630), 4,
-      anon_sym_LBRACE,
-      sym_var,
-      sym_cons_name,
-      anon_sym_LBRACK,
-  [5607] = 6,
+    ACTIONS(1043), 1,
+      anon_sym_COLON,
+  [14800] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(656), 1,
-      anon_sym_RBRACE,
-    ACTIONS(658), 1,
+    ACTIONS(1045), 1,
       anon_sym_COMMA,
-  [5626] = 5,
+    ACTIONS(1047), 1,
+      anon_sym_RPAREN,
+  [14810] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(265), 1,
+      anon_sym_else,
+    ACTIONS(263), 6,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(660), 2,
-      ts_builtin_sym_end,
-      anon_sym_RPAREN,
-  [5643] = 3,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [14825] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(662), 1,
-      anon_sym_GT,
-    ACTIONS(630), 4,
-      anon_sym_LBRACE,
+    ACTIONS(267), 1,
       sym_var,
-      sym_cons_name,
-      anon_sym_LBRACK,
-  [5656] = 3,
+    ACTIONS(1049), 1,
+      anon_sym_DASH_GT,
+  [14835] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(664), 1,
-      anon_sym_GT,
-    ACTIONS(630), 4,
-      anon_sym_LBRACE,
+    ACTIONS(833), 1,
       sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
       sym_cons_name,
-      anon_sym_LBRACK,
-  [5669] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(622), 1,
+    ACTIONS(843), 1,
       sym_sym,
-    ACTIONS(666), 1,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
       anon_sym_BSLASH,
-    ACTIONS(668), 1,
-      anon_sym_LF,
-  [5688] = 6,
-    ACTIONS(53), 1,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(637), 1,
+      sym__expr,
+  [14911] = 6,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(546), 1,
+    ACTIONS(421), 1,
+      anon_sym_else,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
+    ACTIONS(933), 1,
       anon_sym_DOT,
-    ACTIONS(622), 1,
-      sym_sym,
-    ACTIONS(670), 1,
+    ACTIONS(271), 3,
       anon_sym_BSLASH,
-    ACTIONS(672), 1,
+      anon_sym_SEMI,
       anon_sym_LF,
-  [5707] = 3,
+  [14932] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(674), 1,
-      anon_sym_GT,
-    ACTIONS(630), 4,
-      anon_sym_LBRACE,
-      sym_var,
+    ACTIONS(1051), 1,
+      anon_sym_COMMA,
+    ACTIONS(1053), 1,
+      anon_sym_DASH_GT,
+  [14942] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(1055), 1,
       sym_cons_name,
-      anon_sym_LBRACK,
-  [5720] = 6,
-    ACTIONS(53), 1,
+    STATE(641), 1,
+      aux_sym_when_repeat1,
+  [14952] = 4,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(410), 1,
-      anon_sym_LF,
-    ACTIONS(546), 1,
-      anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(608), 1,
-      anon_sym_BSLASH,
-    ACTIONS(622), 1,
+    ACTIONS(1057), 1,
+      anon_sym_RBRACK,
+    ACTIONS(287), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(289), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
       sym_sym,
-  [5739] = 6,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(420), 1,
-      anon_sym_LF,
-    ACTIONS(546), 1,
       anon_sym_LPAREN,
-    ACTIONS(550), 1,
-      anon_sym_DOT,
-    ACTIONS(614), 1,
       anon_sym_BSLASH,
-    ACTIONS(622), 1,
-      sym_sym,
-  [5758] = 6,
+      anon_sym_LBRACK,
+  [14974] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(295), 1,
+      anon_sym_else,
+    ACTIONS(293), 6,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(676), 1,
-      anon_sym_COMMA,
-    ACTIONS(678), 1,
-      anon_sym_RPAREN,
-  [5777] = 6,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [14989] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(680), 1,
+    ACTIONS(1059), 1,
       anon_sym_COMMA,
-    ACTIONS(682), 1,
+    ACTIONS(1061), 1,
       anon_sym_RBRACK,
-  [5796] = 6,
+  [15008] = 33,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(370), 1,
-      anon_sym_RBRACK,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
-      anon_sym_DOT,
-    ACTIONS(470), 1,
+    ACTIONS(5), 1,
+      sym_var,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
       sym_sym,
-    ACTIONS(684), 1,
-      anon_sym_COMMA,
-  [5815] = 5,
+    ACTIONS(17), 1,
+      anon_sym_LPAREN,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(25), 1,
+      anon_sym_import,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    STATE(15), 1,
+      sym__expr,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(25), 1,
+      sym_tuple,
+    STATE(26), 1,
+      sym_assign,
+    STATE(27), 1,
+      sym_bind,
+    STATE(28), 1,
+      sym_annot,
+    STATE(29), 1,
+      sym_import,
+    STATE(30), 1,
+      sym__decl,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(34), 1,
+      aux_sym__inner_block_repeat1,
+    STATE(539), 1,
+      sym__inner_block,
+  [15108] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(374), 1,
+    ACTIONS(307), 1,
+      anon_sym_else,
+    ACTIONS(305), 6,
       sym_sym,
-    ACTIONS(376), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(686), 1,
       anon_sym_SEMI,
-  [5831] = 5,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(688), 1,
-      sym_lit_str,
-    ACTIONS(690), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(692), 1,
-      anon_sym_LBRACE,
-    STATE(208), 1,
-      aux_sym_str_repeat1,
-  [5847] = 5,
+      anon_sym_LF,
+  [15123] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
-      anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(309), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(694), 1,
-      anon_sym_is,
-  [5863] = 5,
+    ACTIONS(1003), 1,
+      anon_sym_LPAREN,
+    STATE(645), 1,
+      sym_block,
+  [15136] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(313), 1,
+      anon_sym_else,
+    ACTIONS(931), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(933), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
+    ACTIONS(311), 4,
       sym_sym,
-    ACTIONS(696), 1,
-      anon_sym_is,
-  [5879] = 5,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [15155] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(317), 1,
+      anon_sym_else,
+    ACTIONS(315), 6,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(698), 1,
-      anon_sym_is,
-  [5895] = 5,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(700), 1,
-      sym_lit_str,
-    ACTIONS(703), 1,
-      anon_sym_BQUOTE,
-    ACTIONS(705), 1,
-      anon_sym_LBRACE,
-    STATE(208), 1,
-      aux_sym_str_repeat1,
-  [5911] = 5,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [15170] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(79), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(708), 1,
+    ACTIONS(1063), 1,
       anon_sym_COMMA,
-  [5927] = 5,
-    ACTIONS(53), 1,
+    ACTIONS(1065), 1,
+      anon_sym_RPAREN,
+  [15189] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(692), 1,
-      anon_sym_LBRACE,
-    ACTIONS(710), 1,
-      sym_lit_str,
-    ACTIONS(712), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-    STATE(211), 1,
-      aux_sym_str_repeat1,
-  [5943] = 5,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(688), 1,
-      sym_lit_str,
-    ACTIONS(692), 1,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-    ACTIONS(714), 1,
-      anon_sym_BQUOTE,
-    STATE(208), 1,
-      aux_sym_str_repeat1,
-  [5959] = 5,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(648), 1,
+      sym__expr,
+  [15265] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(325), 1,
+      anon_sym_else,
+    ACTIONS(323), 6,
+      sym_sym,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+      anon_sym_BSLASH,
       anon_sym_DOT,
-    ACTIONS(470), 1,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [15280] = 25,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
       sym_sym,
-    ACTIONS(716), 1,
-      anon_sym_is,
-  [5975] = 5,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(649), 1,
+      sym__expr,
+  [15356] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(243), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(718), 1,
-      anon_sym_RBRACE,
-  [5991] = 3,
+    ACTIONS(973), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [15373] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(722), 1,
-      anon_sym_LT,
-    ACTIONS(720), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6003] = 5,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(650), 1,
+      sym__expr,
+  [15449] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(376), 1,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
       anon_sym_LPAREN,
-    ACTIONS(378), 1,
+    ACTIONS(243), 1,
       anon_sym_DOT,
-    ACTIONS(470), 1,
-      sym_sym,
-    ACTIONS(724), 1,
-      anon_sym_COMMA,
-  [6019] = 5,
-    ACTIONS(53), 1,
+    ACTIONS(973), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [15466] = 4,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(692), 1,
-      anon_sym_LBRACE,
-    ACTIONS(726), 1,
-      sym_lit_str,
-    ACTIONS(728), 1,
-      anon_sym_BQUOTE,
-    STATE(204), 1,
-      aux_sym_str_repeat1,
-  [6035] = 2,
+    ACTIONS(975), 1,
+      sym_cons_name,
+    ACTIONS(1067), 1,
+      anon_sym_else,
+    ACTIONS(973), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [15483] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(630), 4,
-      anon_sym_LBRACE,
+    ACTIONS(103), 1,
       sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
       sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
       anon_sym_LBRACK,
-  [6045] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(730), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6054] = 2,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(652), 1,
+      sym__expr,
+  [15559] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(732), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6063] = 4,
+    ACTIONS(975), 1,
+      sym_cons_name,
+    ACTIONS(1069), 1,
+      anon_sym_else,
+    ACTIONS(973), 5,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_LF,
+  [15576] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(734), 1,
+    ACTIONS(103), 1,
       sym_var,
-    ACTIONS(736), 1,
-      anon_sym_RBRACE,
-    STATE(247), 1,
-      aux_sym_rec_repeat1,
-  [6076] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(738), 1,
-      anon_sym_LT,
-    ACTIONS(720), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6087] = 4,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(512), 1,
-      anon_sym_COLON,
-    ACTIONS(740), 1,
-      anon_sym_EQ,
-    ACTIONS(742), 1,
-      anon_sym_LT_DASH,
-  [6100] = 4,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(654), 1,
+      sym__expr,
+  [15652] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(744), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(746), 1,
-      anon_sym_RBRACE,
-    STATE(310), 1,
-      aux_sym_type_rec_repeat1,
-  [6113] = 4,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(748), 1,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
       sym_cons_name,
-    ACTIONS(750), 1,
-      anon_sym_RBRACK,
-    STATE(302), 1,
-      aux_sym_type_union_repeat1,
-  [6126] = 4,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(655), 1,
+      sym__expr,
+  [15728] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(752), 1,
-      sym_var,
-    ACTIONS(754), 1,
-      anon_sym_RBRACE,
-    STATE(304), 1,
-      aux_sym_rec_repeat1,
-  [6139] = 4,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(409), 1,
+      anon_sym_SEMI,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(551), 2,
+      anon_sym_is,
+      anon_sym_else,
+  [15748] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(756), 1,
+    ACTIONS(411), 1,
       sym_var,
-    ACTIONS(758), 1,
+    ACTIONS(1071), 1,
       anon_sym_DASH_GT,
-    STATE(289), 1,
-      aux_sym_lam_repeat1,
-  [6152] = 4,
+  [15758] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(760), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(762), 1,
-      anon_sym_RBRACE,
-    STATE(259), 1,
-      aux_sym_type_rec_repeat1,
-  [6165] = 4,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(720), 1,
-      anon_sym_LF,
-    ACTIONS(764), 1,
-      anon_sym_BSLASH,
-    ACTIONS(766), 1,
-      anon_sym_LT,
-  [6178] = 4,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(768), 1,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
       sym_cons_name,
-    ACTIONS(770), 1,
-      anon_sym_RBRACK,
-    STATE(329), 1,
-      aux_sym_type_union_repeat1,
-  [6191] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(772), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6200] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(774), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6209] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(776), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6218] = 4,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(657), 1,
+      sym__expr,
+  [15834] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(778), 1,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    ACTIONS(1073), 1,
       sym_var,
-    ACTIONS(780), 1,
-      anon_sym_RBRACE,
-    STATE(307), 1,
-      aux_sym_type_rec_repeat1,
-  [6231] = 4,
+    STATE(659), 1,
+      sym_tuple,
+  [15847] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(782), 1,
+    ACTIONS(1075), 1,
       sym_cons_name,
-    ACTIONS(784), 1,
-      anon_sym_RBRACK,
-    STATE(299), 1,
-      aux_sym_type_union_repeat1,
-  [6244] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(786), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6253] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(788), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6262] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(790), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6271] = 2,
+  [15854] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(792), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6280] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(1077), 1,
+      anon_sym_is,
+  [15870] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(794), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6289] = 4,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(1077), 1,
+      anon_sym_is,
+  [15886] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(796), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(798), 1,
-      anon_sym_DASH_GT,
-    STATE(276), 1,
-      aux_sym_lam_repeat1,
-  [6302] = 2,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(661), 1,
+      sym__expr,
+  [15962] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(800), 3,
-      anon_sym_RBRACE,
-      anon_sym_COMMA,
-      anon_sym_RBRACK,
-  [6311] = 4,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(1077), 1,
+      anon_sym_is,
+  [15978] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(802), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(804), 1,
-      anon_sym_DASH_GT,
-    STATE(346), 1,
-      aux_sym_lam_repeat1,
-  [6324] = 2,
+    ACTIONS(57), 1,
+      sym_cons_name,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(61), 1,
+      anon_sym_BSLASH,
+    ACTIONS(63), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(662), 1,
+      sym__expr,
+  [16054] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(1077), 1,
+      anon_sym_is,
+  [16070] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(806), 3,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(665), 1,
+      anon_sym_else,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(545), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
       anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
       anon_sym_RBRACK,
-  [6333] = 2,
-    ACTIONS(53), 1,
+  [16095] = 25,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(808), 3,
-      sym_lit_str,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
+    ACTIONS(11), 1,
       anon_sym_LBRACE,
-  [6342] = 4,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(810), 1,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(812), 1,
-      anon_sym_DASH_GT,
-    STATE(339), 1,
-      aux_sym_lam_repeat1,
-  [6355] = 3,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(663), 1,
+      sym__expr,
+  [16171] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(648), 1,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(665), 1,
+      anon_sym_else,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(545), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
-    ACTIONS(814), 1,
-      sym_var,
-  [6365] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(816), 1,
-      sym_var,
-    STATE(292), 1,
-      aux_sym_rec_repeat1,
-  [6375] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(818), 1,
       anon_sym_COMMA,
-    ACTIONS(820), 1,
-      anon_sym_DASH_GT,
-  [6385] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(806), 1,
-      anon_sym_LF,
-    ACTIONS(822), 1,
-      anon_sym_BSLASH,
-  [6395] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(790), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6403] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(824), 1,
-      sym_var,
-    ACTIONS(826), 1,
-      anon_sym_DASH_GT,
-  [6413] = 3,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [16196] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(826), 1,
+    ACTIONS(1079), 1,
       anon_sym_DASH_GT,
-    ACTIONS(828), 1,
-      anon_sym_COMMA,
-  [6423] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(792), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6431] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(794), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6439] = 3,
+  [16203] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(824), 1,
-      sym_var,
-    ACTIONS(830), 1,
+    ACTIONS(1081), 1,
       anon_sym_DASH_GT,
-  [6449] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(800), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6457] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(730), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6465] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(806), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6473] = 3,
+  [16210] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(832), 1,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    ACTIONS(1083), 1,
       sym_var,
-    STATE(288), 1,
-      aux_sym_type_rec_repeat1,
-  [6483] = 3,
+    STATE(667), 1,
+      sym_tuple,
+  [16223] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(834), 1,
-      anon_sym_COMMA2,
-    ACTIONS(836), 1,
-      anon_sym_GT,
-  [6493] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(838), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(1085), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
       anon_sym_COMMA,
-    ACTIONS(840), 1,
+      anon_sym_RPAREN,
       anon_sym_RBRACK,
-  [6503] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(824), 1,
-      sym_var,
-    ACTIONS(842), 1,
-      anon_sym_DASH_GT,
-  [6513] = 3,
+  [16244] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(814), 1,
-      sym_var,
-    ACTIONS(844), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(1085), 6,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
-  [6523] = 3,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_RBRACK,
+  [16265] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(846), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(1087), 1,
       anon_sym_RBRACE,
-    ACTIONS(848), 1,
+    ACTIONS(1089), 1,
       anon_sym_COMMA,
-  [6533] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(794), 1,
-      anon_sym_LF,
-    ACTIONS(850), 1,
-      anon_sym_BSLASH,
-  [6543] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(652), 1,
-      anon_sym_GT,
-    ACTIONS(852), 1,
-      anon_sym_COMMA2,
-  [6553] = 3,
+  [16284] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(854), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
       sym_cons_name,
-    ACTIONS(856), 1,
-      anon_sym_RBRACK,
-  [6563] = 3,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(670), 1,
+      sym__expr,
+  [16360] = 26,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(856), 1,
-      anon_sym_RBRACK,
-    ACTIONS(858), 1,
-      anon_sym_COMMA,
-  [6573] = 3,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(13), 1,
+      sym_cons_name,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(19), 1,
+      anon_sym_BSLASH,
+    ACTIONS(21), 1,
+      anon_sym_when,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(1091), 1,
+      anon_sym_RPAREN,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(264), 1,
+      sym__expr,
+  [16439] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(656), 1,
-      anon_sym_RBRACE,
-    ACTIONS(814), 1,
-      sym_var,
-  [6583] = 3,
+    ACTIONS(549), 1,
+      anon_sym_else,
+    ACTIONS(407), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [16454] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(860), 1,
+    ACTIONS(833), 1,
       sym_var,
-    ACTIONS(862), 1,
-      anon_sym_RBRACE,
-  [6593] = 3,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(672), 1,
+      sym__expr,
+  [16530] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(862), 1,
-      anon_sym_RBRACE,
-    ACTIONS(864), 1,
-      anon_sym_COMMA,
-  [6603] = 2,
+    ACTIONS(551), 1,
+      anon_sym_else,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(409), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [16551] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(732), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6611] = 3,
+    ACTIONS(411), 1,
+      sym_var,
+    ACTIONS(1093), 1,
+      anon_sym_DASH_GT,
+  [16561] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(854), 1,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
       sym_cons_name,
-    ACTIONS(866), 1,
-      anon_sym_RBRACK,
-  [6621] = 3,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(674), 1,
+      sym__expr,
+  [16637] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(860), 1,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    ACTIONS(1095), 1,
       sym_var,
-    ACTIONS(868), 1,
-      anon_sym_RBRACE,
-  [6631] = 3,
+    STATE(676), 1,
+      sym_tuple,
+  [16650] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(854), 1,
+    ACTIONS(1097), 1,
       sym_cons_name,
-    ACTIONS(870), 1,
-      anon_sym_RBRACK,
-  [6641] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(872), 1,
-      sym_var,
-    STATE(323), 1,
-      aux_sym_lam_repeat1,
-  [6651] = 3,
+  [16657] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(874), 1,
-      anon_sym_COMMA2,
-    ACTIONS(876), 1,
-      anon_sym_GT,
-  [6661] = 3,
+    ACTIONS(437), 1,
+      anon_sym_else,
+    ACTIONS(435), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [16672] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(878), 1,
-      anon_sym_COMMA,
-    ACTIONS(880), 1,
+    ACTIONS(1099), 1,
       anon_sym_RBRACK,
-  [6671] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(882), 1,
-      anon_sym_RBRACE,
-    ACTIONS(884), 1,
-      anon_sym_COMMA,
-  [6681] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(662), 1,
-      anon_sym_GT,
-    ACTIONS(886), 1,
-      anon_sym_COMMA2,
-  [6691] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(854), 1,
+    ACTIONS(439), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(441), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
       sym_cons_name,
-    ACTIONS(888), 1,
-      anon_sym_RBRACK,
-  [6701] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(888), 1,
-      anon_sym_RBRACK,
-    ACTIONS(890), 1,
-      anon_sym_COMMA,
-  [6711] = 3,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [16694] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(860), 1,
-      sym_var,
-    ACTIONS(892), 1,
-      anon_sym_RBRACE,
-  [6721] = 3,
+    ACTIONS(437), 1,
+      anon_sym_else,
+    ACTIONS(435), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [16709] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(892), 1,
-      anon_sym_RBRACE,
-    ACTIONS(894), 1,
-      anon_sym_COMMA,
-  [6731] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(732), 1,
-      anon_sym_LF,
-    ACTIONS(896), 1,
+    ACTIONS(453), 1,
+      anon_sym_else,
+    ACTIONS(451), 6,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_BSLASH,
-  [6741] = 3,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [16724] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(854), 1,
+    ACTIONS(1101), 1,
+      anon_sym_RPAREN,
+    ACTIONS(287), 3,
+      sym_var,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(289), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
       sym_cons_name,
-    ACTIONS(898), 1,
-      anon_sym_RBRACK,
-  [6751] = 3,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [16746] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(860), 1,
-      sym_var,
-    ACTIONS(900), 1,
-      anon_sym_RBRACE,
-  [6761] = 3,
+    ACTIONS(459), 1,
+      anon_sym_else,
+    ACTIONS(457), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [16761] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(902), 1,
-      sym_var,
-    STATE(288), 1,
-      aux_sym_type_rec_repeat1,
-  [6771] = 3,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(1103), 1,
+      anon_sym_COMMA,
+    ACTIONS(1105), 1,
+      anon_sym_RPAREN,
+  [16780] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(905), 1,
-      sym_var,
-    STATE(323), 1,
-      aux_sym_lam_repeat1,
-  [6781] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(776), 1,
-      anon_sym_LF,
-    ACTIONS(907), 1,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(1041), 2,
       anon_sym_BSLASH,
-  [6791] = 3,
+      anon_sym_LF,
+  [16797] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(909), 1,
-      anon_sym_COMMA2,
-    ACTIONS(911), 1,
-      anon_sym_GT,
-  [6801] = 3,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(1041), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [16814] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(913), 1,
+    ACTIONS(103), 1,
       sym_var,
-    STATE(292), 1,
-      aux_sym_rec_repeat1,
-  [6811] = 3,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
+      sym_cons_name,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(682), 1,
+      sym__expr,
+  [16890] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(916), 1,
-      anon_sym_COMMA,
-    ACTIONS(918), 1,
-      anon_sym_RBRACK,
-  [6821] = 3,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(1041), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [16907] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(920), 1,
+    ACTIONS(103), 1,
+      sym_var,
+    ACTIONS(105), 1,
+      sym_int,
+    ACTIONS(107), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(109), 1,
+      anon_sym_LBRACE,
+    ACTIONS(111), 1,
       sym_cons_name,
-    STATE(294), 1,
-      aux_sym_type_union_repeat1,
-  [6831] = 3,
+    ACTIONS(113), 1,
+      sym_sym,
+    ACTIONS(115), 1,
+      anon_sym_LPAREN,
+    ACTIONS(117), 1,
+      anon_sym_BSLASH,
+    ACTIONS(119), 1,
+      anon_sym_when,
+    ACTIONS(121), 1,
+      anon_sym_LBRACK,
+    ACTIONS(123), 1,
+      anon_sym_with,
+    STATE(94), 1,
+      sym_str,
+    STATE(95), 1,
+      sym_app,
+    STATE(96), 1,
+      sym_iapp,
+    STATE(97), 1,
+      sym_lam,
+    STATE(98), 1,
+      sym_rec,
+    STATE(99), 1,
+      sym_prop,
+    STATE(100), 1,
+      sym_cons,
+    STATE(101), 1,
+      sym_when,
+    STATE(102), 1,
+      sym_list,
+    STATE(103), 1,
+      sym_tuple,
+    STATE(104), 1,
+      sym_block,
+    STATE(105), 1,
+      sym_with,
+    STATE(683), 1,
+      sym__expr,
+  [16983] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(923), 1,
-      sym_cons_name,
-    STATE(295), 1,
-      aux_sym_when_repeat1,
-  [6841] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(926), 1,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(1041), 2,
       anon_sym_BSLASH,
-    ACTIONS(928), 1,
       anon_sym_LF,
-  [6851] = 3,
-    ACTIONS(53), 1,
+  [17000] = 6,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(800), 1,
-      anon_sym_LF,
-    ACTIONS(930), 1,
-      anon_sym_BSLASH,
-  [6861] = 3,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(545), 1,
+      anon_sym_SEMI,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(665), 2,
+      anon_sym_is,
+      anon_sym_else,
+  [17020] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(932), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
       sym_cons_name,
-    STATE(300), 1,
-      aux_sym_when_repeat1,
-  [6871] = 3,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(684), 1,
+      sym__expr,
+  [17096] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(934), 1,
-      sym_cons_name,
-    STATE(294), 1,
-      aux_sym_type_union_repeat1,
-  [6881] = 3,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(545), 1,
+      anon_sym_SEMI,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(665), 2,
+      anon_sym_is,
+      anon_sym_else,
+  [17116] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(936), 1,
-      sym_cons_name,
-    STATE(295), 1,
-      aux_sym_when_repeat1,
-  [6891] = 3,
+    ACTIONS(1107), 1,
+      anon_sym_DASH_GT,
+  [17123] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(938), 1,
-      sym_cons_name,
-    STATE(303), 1,
-      aux_sym_when_repeat1,
-  [6901] = 3,
+    ACTIONS(1109), 1,
+      anon_sym_DASH_GT,
+  [17130] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(940), 1,
-      sym_cons_name,
-    STATE(294), 1,
-      aux_sym_type_union_repeat1,
-  [6911] = 3,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    ACTIONS(1111), 1,
+      sym_var,
+    STATE(688), 1,
+      sym_tuple,
+  [17143] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(942), 1,
-      sym_cons_name,
-    STATE(295), 1,
-      aux_sym_when_repeat1,
-  [6921] = 3,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(1113), 1,
+      anon_sym_is,
+  [17159] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(944), 1,
-      sym_var,
-    STATE(292), 1,
-      aux_sym_rec_repeat1,
-  [6931] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(165), 1,
+      sym_sym,
+    ACTIONS(1113), 1,
+      anon_sym_is,
+  [17175] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(772), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6939] = 3,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(763), 1,
+      anon_sym_else,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(661), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [17200] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(946), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
       sym_cons_name,
-    STATE(308), 1,
-      aux_sym_when_repeat1,
-  [6949] = 3,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(689), 1,
+      sym__expr,
+  [17276] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(948), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    STATE(288), 1,
-      aux_sym_type_rec_repeat1,
-  [6959] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(950), 1,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
       sym_cons_name,
-    STATE(295), 1,
-      aux_sym_when_repeat1,
-  [6969] = 2,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(690), 1,
+      sym__expr,
+  [17352] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(774), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [6977] = 3,
+    ACTIONS(1115), 1,
+      anon_sym_DASH_GT,
+  [17359] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(952), 1,
-      sym_var,
-    STATE(288), 1,
-      aux_sym_type_rec_repeat1,
-  [6987] = 3,
+    ACTIONS(1117), 1,
+      anon_sym_DASH_GT,
+  [17366] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(860), 1,
-      sym_var,
-    ACTIONS(954), 1,
-      anon_sym_RBRACE,
-  [6997] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(956), 1,
+    ACTIONS(531), 1,
+      anon_sym_else,
+    ACTIONS(529), 6,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_BSLASH,
-    ACTIONS(958), 1,
+      anon_sym_DOT,
+      anon_sym_SEMI,
       anon_sym_LF,
-  [7007] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(824), 1,
-      sym_var,
-    ACTIONS(960), 1,
-      anon_sym_DASH_GT,
-  [7017] = 3,
+  [17381] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(814), 1,
+    ACTIONS(533), 1,
       sym_var,
-    ACTIONS(962), 1,
+    ACTIONS(1119), 1,
       anon_sym_RBRACE,
-  [7027] = 3,
+  [17391] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(964), 1,
+    ACTIONS(75), 1,
+      sym_sym,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(1121), 1,
       anon_sym_RBRACE,
-    ACTIONS(966), 1,
+    ACTIONS(1123), 1,
       anon_sym_COMMA,
-  [7037] = 3,
+  [17410] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(968), 1,
-      sym_cons_name,
-    STATE(295), 1,
-      aux_sym_when_repeat1,
-  [7047] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(786), 1,
-      anon_sym_LF,
-    ACTIONS(970), 1,
+    ACTIONS(663), 1,
+      anon_sym_else,
+    ACTIONS(541), 6,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_BSLASH,
-  [7057] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(674), 1,
-      anon_sym_GT,
-    ACTIONS(972), 1,
-      anon_sym_COMMA2,
-  [7067] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(854), 1,
-      sym_cons_name,
-    ACTIONS(974), 1,
-      anon_sym_RBRACK,
-  [7077] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(788), 1,
+      anon_sym_DOT,
+      anon_sym_SEMI,
       anon_sym_LF,
-    ACTIONS(976), 1,
-      anon_sym_BSLASH,
-  [7087] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(974), 1,
-      anon_sym_RBRACK,
-    ACTIONS(978), 1,
-      anon_sym_COMMA,
-  [7097] = 3,
+  [17425] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(960), 1,
-      anon_sym_DASH_GT,
-    ACTIONS(980), 1,
-      anon_sym_COMMA,
-  [7107] = 3,
+    ACTIONS(665), 1,
+      anon_sym_else,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(545), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [17446] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(982), 1,
+    ACTIONS(833), 1,
       sym_var,
-    STATE(323), 1,
-      aux_sym_lam_repeat1,
-  [7117] = 3,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(985), 1,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
       sym_cons_name,
-    STATE(316), 1,
-      aux_sym_when_repeat1,
-  [7127] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(987), 1,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
       anon_sym_BSLASH,
-    ACTIONS(989), 1,
-      anon_sym_LF,
-  [7137] = 3,
-    ACTIONS(53), 1,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(696), 1,
+      sym__expr,
+  [17522] = 6,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(774), 1,
-      anon_sym_LF,
-    ACTIONS(991), 1,
+    ACTIONS(665), 1,
+      anon_sym_else,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(545), 3,
       anon_sym_BSLASH,
-  [7147] = 3,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [17543] = 2,
     ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(993), 1,
-      anon_sym_COMMA,
-    ACTIONS(995), 1,
+      sym__comment,
+    ACTIONS(1125), 1,
       anon_sym_DASH_GT,
-  [7157] = 2,
+  [17550] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(776), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [7165] = 3,
+    ACTIONS(1127), 1,
+      anon_sym_DASH_GT,
+  [17557] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(997), 1,
-      sym_cons_name,
-    STATE(294), 1,
-      aux_sym_type_union_repeat1,
-  [7175] = 3,
-    ACTIONS(53), 1,
+    ACTIONS(431), 1,
+      anon_sym_LPAREN,
+    ACTIONS(1129), 1,
+      sym_var,
+    STATE(700), 1,
+      sym_tuple,
+  [17570] = 3,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(790), 1,
-      anon_sym_LF,
-    ACTIONS(999), 1,
+    ACTIONS(567), 1,
+      anon_sym_else,
+    ACTIONS(565), 6,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_BSLASH,
-  [7185] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(772), 1,
+      anon_sym_DOT,
+      anon_sym_SEMI,
       anon_sym_LF,
-    ACTIONS(1001), 1,
+  [17585] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(575), 1,
+      anon_sym_else,
+    ACTIONS(573), 6,
+      sym_sym,
+      anon_sym_LPAREN,
       anon_sym_BSLASH,
-  [7195] = 3,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [17600] = 4,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(860), 1,
+    ACTIONS(1131), 1,
+      anon_sym_RPAREN,
+    ACTIONS(439), 3,
       sym_var,
-    ACTIONS(1003), 1,
-      anon_sym_RBRACE,
-  [7205] = 3,
-    ACTIONS(53), 1,
+      anon_sym_when,
+      anon_sym_with,
+    ACTIONS(441), 8,
+      sym_int,
+      anon_sym_BQUOTE,
+      anon_sym_LBRACE,
+      sym_cons_name,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_LBRACK,
+  [17622] = 3,
+    ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(730), 1,
+    ACTIONS(575), 1,
+      anon_sym_else,
+    ACTIONS(573), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
       anon_sym_LF,
-    ACTIONS(1005), 1,
+  [17637] = 5,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(1085), 2,
       anon_sym_BSLASH,
-  [7215] = 2,
+      anon_sym_LF,
+  [17654] = 5,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(786), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [7223] = 3,
+    ACTIONS(237), 1,
+      sym_sym,
+    ACTIONS(239), 1,
+      anon_sym_LPAREN,
+    ACTIONS(243), 1,
+      anon_sym_DOT,
+    ACTIONS(1085), 2,
+      anon_sym_BSLASH,
+      anon_sym_LF,
+  [17671] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1007), 1,
-      anon_sym_COMMA,
-    ACTIONS(1009), 1,
-      anon_sym_DASH_GT,
-  [7233] = 3,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(661), 1,
+      anon_sym_SEMI,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(763), 2,
+      anon_sym_is,
+      anon_sym_else,
+  [17691] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(824), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(1011), 1,
-      anon_sym_DASH_GT,
-  [7243] = 3,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(702), 1,
+      sym__expr,
+  [17767] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1011), 1,
-      anon_sym_DASH_GT,
-    ACTIONS(1013), 1,
-      anon_sym_COMMA,
-  [7253] = 3,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(703), 1,
+      sym__expr,
+  [17843] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(824), 1,
-      sym_var,
-    ACTIONS(1015), 1,
+    ACTIONS(1133), 1,
       anon_sym_DASH_GT,
-  [7263] = 3,
+  [17850] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1017), 1,
-      sym_var,
-    STATE(323), 1,
-      aux_sym_lam_repeat1,
-  [7273] = 3,
+    ACTIONS(1135), 1,
+      anon_sym_DASH_GT,
+  [17857] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1003), 1,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(1137), 1,
+      anon_sym_else,
+    ACTIONS(779), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
       anon_sym_RBRACE,
-    ACTIONS(1019), 1,
       anon_sym_COMMA,
-  [7283] = 2,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [17882] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(788), 2,
-      anon_sym_COMMA2,
-      anon_sym_GT,
-  [7291] = 3,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(1139), 1,
+      anon_sym_else,
+    ACTIONS(779), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [17907] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1021), 1,
-      anon_sym_COMMA,
-    ACTIONS(1023), 1,
-      anon_sym_DASH_GT,
-  [7301] = 3,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(708), 1,
+      sym__expr,
+  [17983] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(824), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-    ACTIONS(1025), 1,
-      anon_sym_DASH_GT,
-  [7311] = 3,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(709), 1,
+      sym__expr,
+  [18059] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1025), 1,
-      anon_sym_DASH_GT,
-    ACTIONS(1027), 1,
-      anon_sym_COMMA,
-  [7321] = 3,
+    ACTIONS(655), 1,
+      anon_sym_else,
+    ACTIONS(653), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [18074] = 3,
+    ACTIONS(3), 1,
+      sym__comment,
+    ACTIONS(655), 1,
+      anon_sym_else,
+    ACTIONS(653), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [18089] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(824), 1,
+    ACTIONS(657), 1,
       sym_var,
-    ACTIONS(1029), 1,
-      anon_sym_DASH_GT,
-  [7331] = 3,
+    ACTIONS(1141), 1,
+      anon_sym_RBRACE,
+  [18099] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1031), 1,
-      sym_var,
-    STATE(323), 1,
-      aux_sym_lam_repeat1,
-  [7341] = 3,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(792), 1,
-      anon_sym_LF,
-    ACTIONS(1033), 1,
+    ACTIONS(763), 1,
+      anon_sym_else,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(661), 3,
       anon_sym_BSLASH,
-  [7351] = 2,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [18120] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1035), 1,
+    ACTIONS(833), 1,
       sym_var,
-  [7358] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(1037), 1,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
       anon_sym_BQUOTE,
-  [7365] = 2,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(711), 1,
+      sym__expr,
+  [18196] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1039), 1,
+    ACTIONS(833), 1,
       sym_var,
-  [7372] = 2,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(712), 1,
+      sym__expr,
+  [18272] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1041), 1,
-      sym_var,
-  [7379] = 2,
+    ACTIONS(1143), 1,
+      anon_sym_DASH_GT,
+  [18279] = 2,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(860), 1,
-      sym_var,
-  [7386] = 2,
+    ACTIONS(1145), 1,
+      anon_sym_DASH_GT,
+  [18286] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1043), 1,
-      sym_var,
-  [7393] = 2,
+    ACTIONS(687), 1,
+      anon_sym_else,
+    ACTIONS(685), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [18301] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1045), 1,
-      anon_sym_DASH_GT,
-  [7400] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(779), 1,
+      anon_sym_SEMI,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(865), 1,
+      anon_sym_is,
+    ACTIONS(1147), 1,
+      anon_sym_else,
+  [18323] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1047), 1,
-      anon_sym_COMMA,
-  [7407] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(779), 1,
+      anon_sym_SEMI,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(865), 1,
+      anon_sym_is,
+    ACTIONS(1149), 1,
+      anon_sym_else,
+  [18345] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1049), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
       anon_sym_BQUOTE,
-  [7414] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(1051), 1,
-      ts_builtin_sym_end,
-  [7421] = 2,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(717), 1,
+      sym__expr,
+  [18421] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1053), 1,
-      anon_sym_COLON,
-  [7428] = 2,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(718), 1,
+      sym__expr,
+  [18497] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1055), 1,
-      anon_sym_DASH_GT,
-  [7435] = 2,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(719), 1,
+      sym__expr,
+  [18573] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1057), 1,
-      anon_sym_DASH_GT,
-  [7442] = 2,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(720), 1,
+      sym__expr,
+  [18649] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1059), 1,
-      sym_var,
-  [7449] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(1151), 1,
+      anon_sym_else,
+    ACTIONS(883), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [18674] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1061), 1,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(1153), 1,
+      anon_sym_else,
+    ACTIONS(883), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
       anon_sym_RPAREN,
-  [7456] = 2,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [18699] = 3,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(824), 1,
-      sym_var,
-  [7463] = 2,
+    ACTIONS(761), 1,
+      anon_sym_else,
+    ACTIONS(759), 6,
+      sym_sym,
+      anon_sym_LPAREN,
+      anon_sym_BSLASH,
+      anon_sym_DOT,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [18714] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1063), 1,
-      anon_sym_DASH_GT,
-  [7470] = 2,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(1155), 1,
+      anon_sym_else,
+    ACTIONS(779), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [18735] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1065), 1,
-      anon_sym_DASH_GT,
-  [7477] = 2,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(1157), 1,
+      anon_sym_else,
+    ACTIONS(779), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [18756] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1067), 1,
+    ACTIONS(833), 1,
       sym_var,
-  [7484] = 2,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(725), 1,
+      sym__expr,
+  [18832] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1069), 1,
+    ACTIONS(833), 1,
       sym_var,
-  [7491] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(1071), 1,
-      anon_sym_COLON,
-  [7498] = 2,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(726), 1,
+      sym__expr,
+  [18908] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1073), 1,
-      anon_sym_DASH_GT,
-  [7505] = 2,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(727), 1,
+      sym__expr,
+  [18984] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1075), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-  [7512] = 2,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(728), 1,
+      sym__expr,
+  [19060] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1077), 1,
-      anon_sym_COLON,
-  [7519] = 2,
-    ACTIONS(53), 1,
-      sym__comment,
-    ACTIONS(1079), 1,
-      sym_lit_str,
-  [7526] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(883), 1,
+      anon_sym_SEMI,
+    ACTIONS(951), 1,
+      anon_sym_is,
+    ACTIONS(1159), 1,
+      anon_sym_else,
+  [19082] = 7,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1081), 1,
-      anon_sym_DASH_GT,
-  [7533] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(883), 1,
+      anon_sym_SEMI,
+    ACTIONS(951), 1,
+      anon_sym_is,
+    ACTIONS(1161), 1,
+      anon_sym_else,
+  [19104] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1083), 1,
-      anon_sym_from,
-  [7540] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(1029), 1,
+      anon_sym_else,
+    ACTIONS(973), 7,
+      ts_builtin_sym_end,
+      anon_sym_COLON,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [19129] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1085), 1,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(1029), 1,
+      anon_sym_else,
+    ACTIONS(973), 7,
+      ts_builtin_sym_end,
       anon_sym_COLON,
-  [7547] = 2,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [19154] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(436), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
       sym_cons_name,
-  [7554] = 2,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(731), 1,
+      sym__expr,
+  [19230] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1087), 1,
-      anon_sym_COLON,
-  [7561] = 2,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(673), 1,
+      sym_cons_name,
+    ACTIONS(675), 1,
+      anon_sym_BSLASH,
+    ACTIONS(677), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(732), 1,
+      sym__expr,
+  [19306] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(814), 1,
+    ACTIONS(833), 1,
       sym_var,
-  [7568] = 2,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(733), 1,
+      sym__expr,
+  [19382] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(854), 1,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
       sym_cons_name,
-  [7575] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(1089), 1,
-      anon_sym_COLON,
-  [7582] = 2,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(734), 1,
+      sym__expr,
+  [19458] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1091), 1,
-      anon_sym_COLON,
-  [7589] = 2,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(1163), 1,
+      anon_sym_else,
+    ACTIONS(883), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [19479] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1093), 1,
-      anon_sym_DASH_GT,
-  [7596] = 2,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(1165), 1,
+      anon_sym_else,
+    ACTIONS(883), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [19500] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1095), 1,
-      sym_var,
-  [7603] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(973), 1,
+      anon_sym_SEMI,
+    ACTIONS(1029), 2,
+      anon_sym_is,
+      anon_sym_else,
+  [19520] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1097), 1,
-      anon_sym_DASH_GT,
-  [7610] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(973), 1,
+      anon_sym_SEMI,
+    ACTIONS(1029), 2,
+      anon_sym_is,
+      anon_sym_else,
+  [19540] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1099), 1,
-      anon_sym_COLON,
-  [7617] = 2,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
+      sym_var,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(737), 1,
+      sym__expr,
+  [19616] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1101), 1,
+    ACTIONS(7), 1,
+      sym_int,
+    ACTIONS(9), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(11), 1,
+      anon_sym_LBRACE,
+    ACTIONS(15), 1,
+      sym_sym,
+    ACTIONS(23), 1,
+      anon_sym_LBRACK,
+    ACTIONS(27), 1,
+      anon_sym_with,
+    ACTIONS(51), 1,
       sym_var,
-  [7624] = 2,
+    ACTIONS(59), 1,
+      anon_sym_LPAREN,
+    ACTIONS(765), 1,
+      sym_cons_name,
+    ACTIONS(767), 1,
+      anon_sym_BSLASH,
+    ACTIONS(769), 1,
+      anon_sym_when,
+    STATE(16), 1,
+      sym_str,
+    STATE(17), 1,
+      sym_app,
+    STATE(18), 1,
+      sym_iapp,
+    STATE(19), 1,
+      sym_lam,
+    STATE(20), 1,
+      sym_rec,
+    STATE(21), 1,
+      sym_prop,
+    STATE(22), 1,
+      sym_cons,
+    STATE(23), 1,
+      sym_when,
+    STATE(24), 1,
+      sym_list,
+    STATE(32), 1,
+      sym_block,
+    STATE(33), 1,
+      sym_with,
+    STATE(47), 1,
+      sym_tuple,
+    STATE(738), 1,
+      sym__expr,
+  [19692] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1103), 1,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(1077), 1,
+      anon_sym_else,
+    ACTIONS(1041), 7,
+      ts_builtin_sym_end,
       anon_sym_COLON,
-  [7631] = 2,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [19717] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1105), 1,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(781), 1,
+      sym_sym,
+    ACTIONS(1077), 1,
+      anon_sym_else,
+    ACTIONS(1041), 7,
+      ts_builtin_sym_end,
       anon_sym_COLON,
-  [7638] = 2,
-    ACTIONS(3), 1,
-      sym__comment,
-    ACTIONS(1107), 1,
-      anon_sym_COMMA2,
-  [7645] = 2,
+      anon_sym_RBRACE,
+      anon_sym_COMMA,
+      anon_sym_RPAREN,
+      anon_sym_SEMI,
+      anon_sym_RBRACK,
+  [19742] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1109), 1,
-      anon_sym_COMMA,
-  [7652] = 2,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(1029), 1,
+      anon_sym_else,
+    ACTIONS(973), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [19763] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1111), 1,
-      anon_sym_COLON,
-  [7659] = 2,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(1029), 1,
+      anon_sym_else,
+    ACTIONS(973), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [19784] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1113), 1,
-      ts_builtin_sym_end,
-  [7666] = 2,
+    ACTIONS(833), 1,
+      sym_var,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(739), 1,
+      sym__expr,
+  [19860] = 25,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1115), 1,
+    ACTIONS(833), 1,
       sym_var,
-  [7673] = 2,
+    ACTIONS(835), 1,
+      sym_int,
+    ACTIONS(837), 1,
+      anon_sym_BQUOTE,
+    ACTIONS(839), 1,
+      anon_sym_LBRACE,
+    ACTIONS(841), 1,
+      sym_cons_name,
+    ACTIONS(843), 1,
+      sym_sym,
+    ACTIONS(845), 1,
+      anon_sym_LPAREN,
+    ACTIONS(847), 1,
+      anon_sym_BSLASH,
+    ACTIONS(849), 1,
+      anon_sym_when,
+    ACTIONS(851), 1,
+      anon_sym_LBRACK,
+    ACTIONS(853), 1,
+      anon_sym_with,
+    STATE(486), 1,
+      sym_str,
+    STATE(487), 1,
+      sym_app,
+    STATE(488), 1,
+      sym_iapp,
+    STATE(489), 1,
+      sym_lam,
+    STATE(490), 1,
+      sym_rec,
+    STATE(491), 1,
+      sym_prop,
+    STATE(492), 1,
+      sym_cons,
+    STATE(493), 1,
+      sym_when,
+    STATE(494), 1,
+      sym_list,
+    STATE(495), 1,
+      sym_tuple,
+    STATE(496), 1,
+      sym_block,
+    STATE(497), 1,
+      sym_with,
+    STATE(740), 1,
+      sym__expr,
+  [19936] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1117), 1,
-      anon_sym_COLON,
-  [7680] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(1041), 1,
+      anon_sym_SEMI,
+    ACTIONS(1077), 2,
+      anon_sym_is,
+      anon_sym_else,
+  [19956] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1119), 1,
-      anon_sym_COMMA,
-  [7687] = 2,
+    ACTIONS(77), 1,
+      anon_sym_LPAREN,
+    ACTIONS(79), 1,
+      anon_sym_DOT,
+    ACTIONS(863), 1,
+      sym_sym,
+    ACTIONS(1041), 1,
+      anon_sym_SEMI,
+    ACTIONS(1077), 2,
+      anon_sym_is,
+      anon_sym_else,
+  [19976] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1121), 1,
-      anon_sym_RPAREN,
-  [7694] = 2,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(1077), 1,
+      anon_sym_else,
+    ACTIONS(1041), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
+  [19997] = 6,
     ACTIONS(3), 1,
       sym__comment,
-    ACTIONS(1123), 1,
-      sym_var,
+    ACTIONS(929), 1,
+      sym_sym,
+    ACTIONS(931), 1,
+      anon_sym_LPAREN,
+    ACTIONS(933), 1,
+      anon_sym_DOT,
+    ACTIONS(1077), 1,
+      anon_sym_else,
+    ACTIONS(1041), 3,
+      anon_sym_BSLASH,
+      anon_sym_SEMI,
+      anon_sym_LF,
 };
 
 static const uint32_t ts_small_parse_table_map[] = {
   [SMALL_STATE(2)] = 0,
-  [SMALL_STATE(3)] = 56,
-  [SMALL_STATE(4)] = 117,
-  [SMALL_STATE(5)] = 178,
-  [SMALL_STATE(6)] = 232,
-  [SMALL_STATE(7)] = 290,
-  [SMALL_STATE(8)] = 337,
-  [SMALL_STATE(9)] = 388,
-  [SMALL_STATE(10)] = 439,
-  [SMALL_STATE(11)] = 484,
-  [SMALL_STATE(12)] = 535,
-  [SMALL_STATE(13)] = 586,
-  [SMALL_STATE(14)] = 634,
-  [SMALL_STATE(15)] = 682,
-  [SMALL_STATE(16)] = 730,
-  [SMALL_STATE(17)] = 778,
-  [SMALL_STATE(18)] = 826,
-  [SMALL_STATE(19)] = 871,
-  [SMALL_STATE(20)] = 916,
-  [SMALL_STATE(21)] = 961,
-  [SMALL_STATE(22)] = 1006,
-  [SMALL_STATE(23)] = 1051,
-  [SMALL_STATE(24)] = 1096,
-  [SMALL_STATE(25)] = 1141,
-  [SMALL_STATE(26)] = 1186,
-  [SMALL_STATE(27)] = 1231,
-  [SMALL_STATE(28)] = 1276,
-  [SMALL_STATE(29)] = 1321,
-  [SMALL_STATE(30)] = 1366,
-  [SMALL_STATE(31)] = 1411,
-  [SMALL_STATE(32)] = 1456,
-  [SMALL_STATE(33)] = 1501,
-  [SMALL_STATE(34)] = 1546,
-  [SMALL_STATE(35)] = 1591,
-  [SMALL_STATE(36)] = 1636,
-  [SMALL_STATE(37)] = 1681,
-  [SMALL_STATE(38)] = 1726,
-  [SMALL_STATE(39)] = 1771,
-  [SMALL_STATE(40)] = 1816,
-  [SMALL_STATE(41)] = 1861,
-  [SMALL_STATE(42)] = 1906,
-  [SMALL_STATE(43)] = 1951,
-  [SMALL_STATE(44)] = 1996,
-  [SMALL_STATE(45)] = 2041,
-  [SMALL_STATE(46)] = 2086,
-  [SMALL_STATE(47)] = 2131,
-  [SMALL_STATE(48)] = 2176,
-  [SMALL_STATE(49)] = 2221,
-  [SMALL_STATE(50)] = 2266,
-  [SMALL_STATE(51)] = 2311,
-  [SMALL_STATE(52)] = 2356,
-  [SMALL_STATE(53)] = 2401,
-  [SMALL_STATE(54)] = 2446,
-  [SMALL_STATE(55)] = 2491,
-  [SMALL_STATE(56)] = 2536,
-  [SMALL_STATE(57)] = 2581,
-  [SMALL_STATE(58)] = 2626,
-  [SMALL_STATE(59)] = 2671,
-  [SMALL_STATE(60)] = 2716,
-  [SMALL_STATE(61)] = 2761,
-  [SMALL_STATE(62)] = 2806,
-  [SMALL_STATE(63)] = 2851,
-  [SMALL_STATE(64)] = 2896,
-  [SMALL_STATE(65)] = 2941,
-  [SMALL_STATE(66)] = 2986,
-  [SMALL_STATE(67)] = 3031,
-  [SMALL_STATE(68)] = 3076,
-  [SMALL_STATE(69)] = 3121,
-  [SMALL_STATE(70)] = 3166,
-  [SMALL_STATE(71)] = 3211,
-  [SMALL_STATE(72)] = 3244,
-  [SMALL_STATE(73)] = 3261,
-  [SMALL_STATE(74)] = 3282,
-  [SMALL_STATE(75)] = 3303,
-  [SMALL_STATE(76)] = 3320,
-  [SMALL_STATE(77)] = 3337,
-  [SMALL_STATE(78)] = 3358,
-  [SMALL_STATE(79)] = 3383,
-  [SMALL_STATE(80)] = 3408,
-  [SMALL_STATE(81)] = 3425,
-  [SMALL_STATE(82)] = 3446,
-  [SMALL_STATE(83)] = 3467,
-  [SMALL_STATE(84)] = 3488,
-  [SMALL_STATE(85)] = 3509,
-  [SMALL_STATE(86)] = 3526,
-  [SMALL_STATE(87)] = 3543,
-  [SMALL_STATE(88)] = 3560,
-  [SMALL_STATE(89)] = 3577,
-  [SMALL_STATE(90)] = 3598,
-  [SMALL_STATE(91)] = 3615,
-  [SMALL_STATE(92)] = 3632,
-  [SMALL_STATE(93)] = 3655,
-  [SMALL_STATE(94)] = 3672,
-  [SMALL_STATE(95)] = 3695,
-  [SMALL_STATE(96)] = 3718,
-  [SMALL_STATE(97)] = 3735,
-  [SMALL_STATE(98)] = 3758,
-  [SMALL_STATE(99)] = 3785,
-  [SMALL_STATE(100)] = 3808,
-  [SMALL_STATE(101)] = 3831,
-  [SMALL_STATE(102)] = 3852,
-  [SMALL_STATE(103)] = 3875,
-  [SMALL_STATE(104)] = 3892,
-  [SMALL_STATE(105)] = 3913,
-  [SMALL_STATE(106)] = 3930,
-  [SMALL_STATE(107)] = 3957,
-  [SMALL_STATE(108)] = 3974,
-  [SMALL_STATE(109)] = 3991,
-  [SMALL_STATE(110)] = 4012,
-  [SMALL_STATE(111)] = 4031,
-  [SMALL_STATE(112)] = 4048,
-  [SMALL_STATE(113)] = 4076,
-  [SMALL_STATE(114)] = 4104,
-  [SMALL_STATE(115)] = 4132,
-  [SMALL_STATE(116)] = 4150,
-  [SMALL_STATE(117)] = 4171,
-  [SMALL_STATE(118)] = 4192,
-  [SMALL_STATE(119)] = 4213,
-  [SMALL_STATE(120)] = 4238,
-  [SMALL_STATE(121)] = 4259,
-  [SMALL_STATE(122)] = 4284,
-  [SMALL_STATE(123)] = 4305,
-  [SMALL_STATE(124)] = 4326,
-  [SMALL_STATE(125)] = 4351,
-  [SMALL_STATE(126)] = 4372,
-  [SMALL_STATE(127)] = 4397,
-  [SMALL_STATE(128)] = 4419,
-  [SMALL_STATE(129)] = 4441,
-  [SMALL_STATE(130)] = 4463,
-  [SMALL_STATE(131)] = 4485,
-  [SMALL_STATE(132)] = 4507,
-  [SMALL_STATE(133)] = 4529,
-  [SMALL_STATE(134)] = 4551,
-  [SMALL_STATE(135)] = 4573,
-  [SMALL_STATE(136)] = 4595,
-  [SMALL_STATE(137)] = 4617,
-  [SMALL_STATE(138)] = 4639,
-  [SMALL_STATE(139)] = 4661,
-  [SMALL_STATE(140)] = 4683,
-  [SMALL_STATE(141)] = 4705,
-  [SMALL_STATE(142)] = 4727,
-  [SMALL_STATE(143)] = 4749,
-  [SMALL_STATE(144)] = 4764,
-  [SMALL_STATE(145)] = 4785,
-  [SMALL_STATE(146)] = 4800,
-  [SMALL_STATE(147)] = 4815,
-  [SMALL_STATE(148)] = 4830,
-  [SMALL_STATE(149)] = 4845,
-  [SMALL_STATE(150)] = 4860,
-  [SMALL_STATE(151)] = 4875,
-  [SMALL_STATE(152)] = 4890,
-  [SMALL_STATE(153)] = 4909,
-  [SMALL_STATE(154)] = 4924,
-  [SMALL_STATE(155)] = 4939,
-  [SMALL_STATE(156)] = 4954,
-  [SMALL_STATE(157)] = 4969,
-  [SMALL_STATE(158)] = 4984,
-  [SMALL_STATE(159)] = 4999,
-  [SMALL_STATE(160)] = 5014,
-  [SMALL_STATE(161)] = 5029,
-  [SMALL_STATE(162)] = 5044,
-  [SMALL_STATE(163)] = 5069,
-  [SMALL_STATE(164)] = 5088,
-  [SMALL_STATE(165)] = 5113,
-  [SMALL_STATE(166)] = 5132,
-  [SMALL_STATE(167)] = 5155,
-  [SMALL_STATE(168)] = 5178,
-  [SMALL_STATE(169)] = 5199,
-  [SMALL_STATE(170)] = 5220,
-  [SMALL_STATE(171)] = 5241,
-  [SMALL_STATE(172)] = 5262,
-  [SMALL_STATE(173)] = 5283,
-  [SMALL_STATE(174)] = 5304,
-  [SMALL_STATE(175)] = 5323,
-  [SMALL_STATE(176)] = 5342,
-  [SMALL_STATE(177)] = 5361,
-  [SMALL_STATE(178)] = 5380,
-  [SMALL_STATE(179)] = 5399,
-  [SMALL_STATE(180)] = 5418,
-  [SMALL_STATE(181)] = 5435,
-  [SMALL_STATE(182)] = 5454,
-  [SMALL_STATE(183)] = 5467,
-  [SMALL_STATE(184)] = 5486,
-  [SMALL_STATE(185)] = 5505,
-  [SMALL_STATE(186)] = 5524,
-  [SMALL_STATE(187)] = 5543,
-  [SMALL_STATE(188)] = 5562,
-  [SMALL_STATE(189)] = 5581,
-  [SMALL_STATE(190)] = 5594,
-  [SMALL_STATE(191)] = 5607,
-  [SMALL_STATE(192)] = 5626,
-  [SMALL_STATE(193)] = 5643,
-  [SMALL_STATE(194)] = 5656,
-  [SMALL_STATE(195)] = 5669,
-  [SMALL_STATE(196)] = 5688,
-  [SMALL_STATE(197)] = 5707,
-  [SMALL_STATE(198)] = 5720,
-  [SMALL_STATE(199)] = 5739,
-  [SMALL_STATE(200)] = 5758,
-  [SMALL_STATE(201)] = 5777,
-  [SMALL_STATE(202)] = 5796,
-  [SMALL_STATE(203)] = 5815,
-  [SMALL_STATE(204)] = 5831,
-  [SMALL_STATE(205)] = 5847,
-  [SMALL_STATE(206)] = 5863,
-  [SMALL_STATE(207)] = 5879,
-  [SMALL_STATE(208)] = 5895,
-  [SMALL_STATE(209)] = 5911,
-  [SMALL_STATE(210)] = 5927,
-  [SMALL_STATE(211)] = 5943,
-  [SMALL_STATE(212)] = 5959,
-  [SMALL_STATE(213)] = 5975,
-  [SMALL_STATE(214)] = 5991,
-  [SMALL_STATE(215)] = 6003,
-  [SMALL_STATE(216)] = 6019,
-  [SMALL_STATE(217)] = 6035,
-  [SMALL_STATE(218)] = 6045,
-  [SMALL_STATE(219)] = 6054,
-  [SMALL_STATE(220)] = 6063,
-  [SMALL_STATE(221)] = 6076,
-  [SMALL_STATE(222)] = 6087,
-  [SMALL_STATE(223)] = 6100,
-  [SMALL_STATE(224)] = 6113,
-  [SMALL_STATE(225)] = 6126,
-  [SMALL_STATE(226)] = 6139,
-  [SMALL_STATE(227)] = 6152,
-  [SMALL_STATE(228)] = 6165,
-  [SMALL_STATE(229)] = 6178,
-  [SMALL_STATE(230)] = 6191,
-  [SMALL_STATE(231)] = 6200,
-  [SMALL_STATE(232)] = 6209,
-  [SMALL_STATE(233)] = 6218,
-  [SMALL_STATE(234)] = 6231,
-  [SMALL_STATE(235)] = 6244,
-  [SMALL_STATE(236)] = 6253,
-  [SMALL_STATE(237)] = 6262,
-  [SMALL_STATE(238)] = 6271,
-  [SMALL_STATE(239)] = 6280,
-  [SMALL_STATE(240)] = 6289,
-  [SMALL_STATE(241)] = 6302,
-  [SMALL_STATE(242)] = 6311,
-  [SMALL_STATE(243)] = 6324,
-  [SMALL_STATE(244)] = 6333,
-  [SMALL_STATE(245)] = 6342,
-  [SMALL_STATE(246)] = 6355,
-  [SMALL_STATE(247)] = 6365,
-  [SMALL_STATE(248)] = 6375,
-  [SMALL_STATE(249)] = 6385,
-  [SMALL_STATE(250)] = 6395,
-  [SMALL_STATE(251)] = 6403,
-  [SMALL_STATE(252)] = 6413,
-  [SMALL_STATE(253)] = 6423,
-  [SMALL_STATE(254)] = 6431,
-  [SMALL_STATE(255)] = 6439,
-  [SMALL_STATE(256)] = 6449,
-  [SMALL_STATE(257)] = 6457,
-  [SMALL_STATE(258)] = 6465,
-  [SMALL_STATE(259)] = 6473,
-  [SMALL_STATE(260)] = 6483,
-  [SMALL_STATE(261)] = 6493,
-  [SMALL_STATE(262)] = 6503,
-  [SMALL_STATE(263)] = 6513,
-  [SMALL_STATE(264)] = 6523,
-  [SMALL_STATE(265)] = 6533,
-  [SMALL_STATE(266)] = 6543,
-  [SMALL_STATE(267)] = 6553,
-  [SMALL_STATE(268)] = 6563,
-  [SMALL_STATE(269)] = 6573,
-  [SMALL_STATE(270)] = 6583,
-  [SMALL_STATE(271)] = 6593,
-  [SMALL_STATE(272)] = 6603,
-  [SMALL_STATE(273)] = 6611,
-  [SMALL_STATE(274)] = 6621,
-  [SMALL_STATE(275)] = 6631,
-  [SMALL_STATE(276)] = 6641,
-  [SMALL_STATE(277)] = 6651,
-  [SMALL_STATE(278)] = 6661,
-  [SMALL_STATE(279)] = 6671,
-  [SMALL_STATE(280)] = 6681,
-  [SMALL_STATE(281)] = 6691,
-  [SMALL_STATE(282)] = 6701,
-  [SMALL_STATE(283)] = 6711,
-  [SMALL_STATE(284)] = 6721,
-  [SMALL_STATE(285)] = 6731,
-  [SMALL_STATE(286)] = 6741,
-  [SMALL_STATE(287)] = 6751,
-  [SMALL_STATE(288)] = 6761,
-  [SMALL_STATE(289)] = 6771,
-  [SMALL_STATE(290)] = 6781,
-  [SMALL_STATE(291)] = 6791,
-  [SMALL_STATE(292)] = 6801,
-  [SMALL_STATE(293)] = 6811,
-  [SMALL_STATE(294)] = 6821,
-  [SMALL_STATE(295)] = 6831,
-  [SMALL_STATE(296)] = 6841,
-  [SMALL_STATE(297)] = 6851,
-  [SMALL_STATE(298)] = 6861,
-  [SMALL_STATE(299)] = 6871,
-  [SMALL_STATE(300)] = 6881,
-  [SMALL_STATE(301)] = 6891,
-  [SMALL_STATE(302)] = 6901,
-  [SMALL_STATE(303)] = 6911,
-  [SMALL_STATE(304)] = 6921,
-  [SMALL_STATE(305)] = 6931,
-  [SMALL_STATE(306)] = 6939,
-  [SMALL_STATE(307)] = 6949,
-  [SMALL_STATE(308)] = 6959,
-  [SMALL_STATE(309)] = 6969,
-  [SMALL_STATE(310)] = 6977,
-  [SMALL_STATE(311)] = 6987,
-  [SMALL_STATE(312)] = 6997,
-  [SMALL_STATE(313)] = 7007,
-  [SMALL_STATE(314)] = 7017,
-  [SMALL_STATE(315)] = 7027,
-  [SMALL_STATE(316)] = 7037,
-  [SMALL_STATE(317)] = 7047,
-  [SMALL_STATE(318)] = 7057,
-  [SMALL_STATE(319)] = 7067,
-  [SMALL_STATE(320)] = 7077,
-  [SMALL_STATE(321)] = 7087,
-  [SMALL_STATE(322)] = 7097,
-  [SMALL_STATE(323)] = 7107,
-  [SMALL_STATE(324)] = 7117,
-  [SMALL_STATE(325)] = 7127,
-  [SMALL_STATE(326)] = 7137,
-  [SMALL_STATE(327)] = 7147,
-  [SMALL_STATE(328)] = 7157,
-  [SMALL_STATE(329)] = 7165,
-  [SMALL_STATE(330)] = 7175,
-  [SMALL_STATE(331)] = 7185,
-  [SMALL_STATE(332)] = 7195,
-  [SMALL_STATE(333)] = 7205,
-  [SMALL_STATE(334)] = 7215,
-  [SMALL_STATE(335)] = 7223,
-  [SMALL_STATE(336)] = 7233,
-  [SMALL_STATE(337)] = 7243,
-  [SMALL_STATE(338)] = 7253,
-  [SMALL_STATE(339)] = 7263,
-  [SMALL_STATE(340)] = 7273,
-  [SMALL_STATE(341)] = 7283,
-  [SMALL_STATE(342)] = 7291,
-  [SMALL_STATE(343)] = 7301,
-  [SMALL_STATE(344)] = 7311,
-  [SMALL_STATE(345)] = 7321,
-  [SMALL_STATE(346)] = 7331,
-  [SMALL_STATE(347)] = 7341,
-  [SMALL_STATE(348)] = 7351,
-  [SMALL_STATE(349)] = 7358,
-  [SMALL_STATE(350)] = 7365,
-  [SMALL_STATE(351)] = 7372,
-  [SMALL_STATE(352)] = 7379,
-  [SMALL_STATE(353)] = 7386,
-  [SMALL_STATE(354)] = 7393,
-  [SMALL_STATE(355)] = 7400,
-  [SMALL_STATE(356)] = 7407,
-  [SMALL_STATE(357)] = 7414,
-  [SMALL_STATE(358)] = 7421,
-  [SMALL_STATE(359)] = 7428,
-  [SMALL_STATE(360)] = 7435,
-  [SMALL_STATE(361)] = 7442,
-  [SMALL_STATE(362)] = 7449,
-  [SMALL_STATE(363)] = 7456,
-  [SMALL_STATE(364)] = 7463,
-  [SMALL_STATE(365)] = 7470,
-  [SMALL_STATE(366)] = 7477,
-  [SMALL_STATE(367)] = 7484,
-  [SMALL_STATE(368)] = 7491,
-  [SMALL_STATE(369)] = 7498,
-  [SMALL_STATE(370)] = 7505,
-  [SMALL_STATE(371)] = 7512,
-  [SMALL_STATE(372)] = 7519,
-  [SMALL_STATE(373)] = 7526,
-  [SMALL_STATE(374)] = 7533,
-  [SMALL_STATE(375)] = 7540,
-  [SMALL_STATE(376)] = 7547,
-  [SMALL_STATE(377)] = 7554,
-  [SMALL_STATE(378)] = 7561,
-  [SMALL_STATE(379)] = 7568,
-  [SMALL_STATE(380)] = 7575,
-  [SMALL_STATE(381)] = 7582,
-  [SMALL_STATE(382)] = 7589,
-  [SMALL_STATE(383)] = 7596,
-  [SMALL_STATE(384)] = 7603,
-  [SMALL_STATE(385)] = 7610,
-  [SMALL_STATE(386)] = 7617,
-  [SMALL_STATE(387)] = 7624,
-  [SMALL_STATE(388)] = 7631,
-  [SMALL_STATE(389)] = 7638,
-  [SMALL_STATE(390)] = 7645,
-  [SMALL_STATE(391)] = 7652,
-  [SMALL_STATE(392)] = 7659,
-  [SMALL_STATE(393)] = 7666,
-  [SMALL_STATE(394)] = 7673,
-  [SMALL_STATE(395)] = 7680,
-  [SMALL_STATE(396)] = 7687,
-  [SMALL_STATE(397)] = 7694,
+  [SMALL_STATE(3)] = 21,
+  [SMALL_STATE(4)] = 41,
+  [SMALL_STATE(5)] = 57,
+  [SMALL_STATE(6)] = 70,
+  [SMALL_STATE(7)] = 151,
+  [SMALL_STATE(8)] = 171,
+  [SMALL_STATE(9)] = 271,
+  [SMALL_STATE(10)] = 284,
+  [SMALL_STATE(11)] = 360,
+  [SMALL_STATE(12)] = 442,
+  [SMALL_STATE(13)] = 449,
+  [SMALL_STATE(14)] = 456,
+  [SMALL_STATE(15)] = 463,
+  [SMALL_STATE(16)] = 480,
+  [SMALL_STATE(17)] = 500,
+  [SMALL_STATE(18)] = 520,
+  [SMALL_STATE(19)] = 540,
+  [SMALL_STATE(20)] = 560,
+  [SMALL_STATE(21)] = 580,
+  [SMALL_STATE(22)] = 600,
+  [SMALL_STATE(23)] = 620,
+  [SMALL_STATE(24)] = 640,
+  [SMALL_STATE(25)] = 660,
+  [SMALL_STATE(26)] = 678,
+  [SMALL_STATE(27)] = 686,
+  [SMALL_STATE(28)] = 694,
+  [SMALL_STATE(29)] = 702,
+  [SMALL_STATE(30)] = 710,
+  [SMALL_STATE(31)] = 720,
+  [SMALL_STATE(32)] = 727,
+  [SMALL_STATE(33)] = 747,
+  [SMALL_STATE(34)] = 767,
+  [SMALL_STATE(35)] = 861,
+  [SMALL_STATE(36)] = 895,
+  [SMALL_STATE(37)] = 971,
+  [SMALL_STATE(38)] = 1047,
+  [SMALL_STATE(39)] = 1056,
+  [SMALL_STATE(40)] = 1076,
+  [SMALL_STATE(41)] = 1152,
+  [SMALL_STATE(42)] = 1165,
+  [SMALL_STATE(43)] = 1172,
+  [SMALL_STATE(44)] = 1192,
+  [SMALL_STATE(45)] = 1199,
+  [SMALL_STATE(46)] = 1219,
+  [SMALL_STATE(47)] = 1239,
+  [SMALL_STATE(48)] = 1259,
+  [SMALL_STATE(49)] = 1281,
+  [SMALL_STATE(50)] = 1288,
+  [SMALL_STATE(51)] = 1298,
+  [SMALL_STATE(52)] = 1374,
+  [SMALL_STATE(53)] = 1381,
+  [SMALL_STATE(54)] = 1459,
+  [SMALL_STATE(55)] = 1559,
+  [SMALL_STATE(56)] = 1572,
+  [SMALL_STATE(57)] = 1648,
+  [SMALL_STATE(58)] = 1664,
+  [SMALL_STATE(59)] = 1684,
+  [SMALL_STATE(60)] = 1703,
+  [SMALL_STATE(61)] = 1779,
+  [SMALL_STATE(62)] = 1786,
+  [SMALL_STATE(63)] = 1802,
+  [SMALL_STATE(64)] = 1878,
+  [SMALL_STATE(65)] = 1960,
+  [SMALL_STATE(66)] = 1967,
+  [SMALL_STATE(67)] = 2043,
+  [SMALL_STATE(68)] = 2119,
+  [SMALL_STATE(69)] = 2139,
+  [SMALL_STATE(70)] = 2159,
+  [SMALL_STATE(71)] = 2176,
+  [SMALL_STATE(72)] = 2186,
+  [SMALL_STATE(73)] = 2194,
+  [SMALL_STATE(74)] = 2207,
+  [SMALL_STATE(75)] = 2218,
+  [SMALL_STATE(76)] = 2252,
+  [SMALL_STATE(77)] = 2265,
+  [SMALL_STATE(78)] = 2273,
+  [SMALL_STATE(79)] = 2281,
+  [SMALL_STATE(80)] = 2289,
+  [SMALL_STATE(81)] = 2297,
+  [SMALL_STATE(82)] = 2305,
+  [SMALL_STATE(83)] = 2316,
+  [SMALL_STATE(84)] = 2327,
+  [SMALL_STATE(85)] = 2343,
+  [SMALL_STATE(86)] = 2356,
+  [SMALL_STATE(87)] = 2430,
+  [SMALL_STATE(88)] = 2441,
+  [SMALL_STATE(89)] = 2541,
+  [SMALL_STATE(90)] = 2554,
+  [SMALL_STATE(91)] = 2630,
+  [SMALL_STATE(92)] = 2712,
+  [SMALL_STATE(93)] = 2719,
+  [SMALL_STATE(94)] = 2736,
+  [SMALL_STATE(95)] = 2747,
+  [SMALL_STATE(96)] = 2758,
+  [SMALL_STATE(97)] = 2769,
+  [SMALL_STATE(98)] = 2780,
+  [SMALL_STATE(99)] = 2791,
+  [SMALL_STATE(100)] = 2802,
+  [SMALL_STATE(101)] = 2813,
+  [SMALL_STATE(102)] = 2824,
+  [SMALL_STATE(103)] = 2835,
+  [SMALL_STATE(104)] = 2846,
+  [SMALL_STATE(105)] = 2857,
+  [SMALL_STATE(106)] = 2868,
+  [SMALL_STATE(107)] = 2885,
+  [SMALL_STATE(108)] = 2904,
+  [SMALL_STATE(109)] = 2913,
+  [SMALL_STATE(110)] = 2933,
+  [SMALL_STATE(111)] = 3009,
+  [SMALL_STATE(112)] = 3085,
+  [SMALL_STATE(113)] = 3092,
+  [SMALL_STATE(114)] = 3168,
+  [SMALL_STATE(115)] = 3178,
+  [SMALL_STATE(116)] = 3198,
+  [SMALL_STATE(117)] = 3208,
+  [SMALL_STATE(118)] = 3284,
+  [SMALL_STATE(119)] = 3305,
+  [SMALL_STATE(120)] = 3315,
+  [SMALL_STATE(121)] = 3337,
+  [SMALL_STATE(122)] = 3347,
+  [SMALL_STATE(123)] = 3423,
+  [SMALL_STATE(124)] = 3430,
+  [SMALL_STATE(125)] = 3446,
+  [SMALL_STATE(126)] = 3522,
+  [SMALL_STATE(127)] = 3532,
+  [SMALL_STATE(128)] = 3554,
+  [SMALL_STATE(129)] = 3574,
+  [SMALL_STATE(130)] = 3593,
+  [SMALL_STATE(131)] = 3600,
+  [SMALL_STATE(132)] = 3700,
+  [SMALL_STATE(133)] = 3707,
+  [SMALL_STATE(134)] = 3727,
+  [SMALL_STATE(135)] = 3740,
+  [SMALL_STATE(136)] = 3764,
+  [SMALL_STATE(137)] = 3784,
+  [SMALL_STATE(138)] = 3803,
+  [SMALL_STATE(139)] = 3879,
+  [SMALL_STATE(140)] = 3899,
+  [SMALL_STATE(141)] = 3916,
+  [SMALL_STATE(142)] = 3933,
+  [SMALL_STATE(143)] = 3953,
+  [SMALL_STATE(144)] = 3973,
+  [SMALL_STATE(145)] = 3980,
+  [SMALL_STATE(146)] = 3988,
+  [SMALL_STATE(147)] = 3995,
+  [SMALL_STATE(148)] = 4035,
+  [SMALL_STATE(149)] = 4045,
+  [SMALL_STATE(150)] = 4058,
+  [SMALL_STATE(151)] = 4071,
+  [SMALL_STATE(152)] = 4105,
+  [SMALL_STATE(153)] = 4118,
+  [SMALL_STATE(154)] = 4128,
+  [SMALL_STATE(155)] = 4138,
+  [SMALL_STATE(156)] = 4148,
+  [SMALL_STATE(157)] = 4158,
+  [SMALL_STATE(158)] = 4168,
+  [SMALL_STATE(159)] = 4202,
+  [SMALL_STATE(160)] = 4210,
+  [SMALL_STATE(161)] = 4217,
+  [SMALL_STATE(162)] = 4228,
+  [SMALL_STATE(163)] = 4241,
+  [SMALL_STATE(164)] = 4248,
+  [SMALL_STATE(165)] = 4259,
+  [SMALL_STATE(166)] = 4266,
+  [SMALL_STATE(167)] = 4277,
+  [SMALL_STATE(168)] = 4299,
+  [SMALL_STATE(169)] = 4306,
+  [SMALL_STATE(170)] = 4316,
+  [SMALL_STATE(171)] = 4392,
+  [SMALL_STATE(172)] = 4399,
+  [SMALL_STATE(173)] = 4415,
+  [SMALL_STATE(174)] = 4426,
+  [SMALL_STATE(175)] = 4445,
+  [SMALL_STATE(176)] = 4521,
+  [SMALL_STATE(177)] = 4537,
+  [SMALL_STATE(178)] = 4613,
+  [SMALL_STATE(179)] = 4695,
+  [SMALL_STATE(180)] = 4702,
+  [SMALL_STATE(181)] = 4709,
+  [SMALL_STATE(182)] = 4718,
+  [SMALL_STATE(183)] = 4737,
+  [SMALL_STATE(184)] = 4756,
+  [SMALL_STATE(185)] = 4832,
+  [SMALL_STATE(186)] = 4849,
+  [SMALL_STATE(187)] = 4928,
+  [SMALL_STATE(188)] = 4942,
+  [SMALL_STATE(189)] = 5018,
+  [SMALL_STATE(190)] = 5039,
+  [SMALL_STATE(191)] = 5049,
+  [SMALL_STATE(192)] = 5125,
+  [SMALL_STATE(193)] = 5135,
+  [SMALL_STATE(194)] = 5145,
+  [SMALL_STATE(195)] = 5221,
+  [SMALL_STATE(196)] = 5237,
+  [SMALL_STATE(197)] = 5247,
+  [SMALL_STATE(198)] = 5257,
+  [SMALL_STATE(199)] = 5270,
+  [SMALL_STATE(200)] = 5277,
+  [SMALL_STATE(201)] = 5297,
+  [SMALL_STATE(202)] = 5319,
+  [SMALL_STATE(203)] = 5339,
+  [SMALL_STATE(204)] = 5346,
+  [SMALL_STATE(205)] = 5354,
+  [SMALL_STATE(206)] = 5361,
+  [SMALL_STATE(207)] = 5381,
+  [SMALL_STATE(208)] = 5403,
+  [SMALL_STATE(209)] = 5423,
+  [SMALL_STATE(210)] = 5442,
+  [SMALL_STATE(211)] = 5476,
+  [SMALL_STATE(212)] = 5483,
+  [SMALL_STATE(213)] = 5491,
+  [SMALL_STATE(214)] = 5504,
+  [SMALL_STATE(215)] = 5515,
+  [SMALL_STATE(216)] = 5549,
+  [SMALL_STATE(217)] = 5562,
+  [SMALL_STATE(218)] = 5570,
+  [SMALL_STATE(219)] = 5580,
+  [SMALL_STATE(220)] = 5588,
+  [SMALL_STATE(221)] = 5596,
+  [SMALL_STATE(222)] = 5604,
+  [SMALL_STATE(223)] = 5612,
+  [SMALL_STATE(224)] = 5646,
+  [SMALL_STATE(225)] = 5653,
+  [SMALL_STATE(226)] = 5663,
+  [SMALL_STATE(227)] = 5670,
+  [SMALL_STATE(228)] = 5710,
+  [SMALL_STATE(229)] = 5720,
+  [SMALL_STATE(230)] = 5754,
+  [SMALL_STATE(231)] = 5764,
+  [SMALL_STATE(232)] = 5771,
+  [SMALL_STATE(233)] = 5805,
+  [SMALL_STATE(234)] = 5815,
+  [SMALL_STATE(235)] = 5825,
+  [SMALL_STATE(236)] = 5859,
+  [SMALL_STATE(237)] = 5870,
+  [SMALL_STATE(238)] = 5946,
+  [SMALL_STATE(239)] = 5953,
+  [SMALL_STATE(240)] = 5963,
+  [SMALL_STATE(241)] = 5974,
+  [SMALL_STATE(242)] = 5984,
+  [SMALL_STATE(243)] = 6060,
+  [SMALL_STATE(244)] = 6077,
+  [SMALL_STATE(245)] = 6087,
+  [SMALL_STATE(246)] = 6097,
+  [SMALL_STATE(247)] = 6119,
+  [SMALL_STATE(248)] = 6130,
+  [SMALL_STATE(249)] = 6149,
+  [SMALL_STATE(250)] = 6249,
+  [SMALL_STATE(251)] = 6260,
+  [SMALL_STATE(252)] = 6273,
+  [SMALL_STATE(253)] = 6288,
+  [SMALL_STATE(254)] = 6299,
+  [SMALL_STATE(255)] = 6318,
+  [SMALL_STATE(256)] = 6394,
+  [SMALL_STATE(257)] = 6405,
+  [SMALL_STATE(258)] = 6412,
+  [SMALL_STATE(259)] = 6419,
+  [SMALL_STATE(260)] = 6428,
+  [SMALL_STATE(261)] = 6448,
+  [SMALL_STATE(262)] = 6458,
+  [SMALL_STATE(263)] = 6477,
+  [SMALL_STATE(264)] = 6491,
+  [SMALL_STATE(265)] = 6508,
+  [SMALL_STATE(266)] = 6529,
+  [SMALL_STATE(267)] = 6605,
+  [SMALL_STATE(268)] = 6626,
+  [SMALL_STATE(269)] = 6705,
+  [SMALL_STATE(270)] = 6725,
+  [SMALL_STATE(271)] = 6801,
+  [SMALL_STATE(272)] = 6817,
+  [SMALL_STATE(273)] = 6827,
+  [SMALL_STATE(274)] = 6903,
+  [SMALL_STATE(275)] = 6916,
+  [SMALL_STATE(276)] = 6923,
+  [SMALL_STATE(277)] = 6930,
+  [SMALL_STATE(278)] = 7006,
+  [SMALL_STATE(279)] = 7013,
+  [SMALL_STATE(280)] = 7026,
+  [SMALL_STATE(281)] = 7046,
+  [SMALL_STATE(282)] = 7053,
+  [SMALL_STATE(283)] = 7061,
+  [SMALL_STATE(284)] = 7081,
+  [SMALL_STATE(285)] = 7103,
+  [SMALL_STATE(286)] = 7123,
+  [SMALL_STATE(287)] = 7133,
+  [SMALL_STATE(288)] = 7167,
+  [SMALL_STATE(289)] = 7174,
+  [SMALL_STATE(290)] = 7182,
+  [SMALL_STATE(291)] = 7189,
+  [SMALL_STATE(292)] = 7229,
+  [SMALL_STATE(293)] = 7239,
+  [SMALL_STATE(294)] = 7273,
+  [SMALL_STATE(295)] = 7281,
+  [SMALL_STATE(296)] = 7288,
+  [SMALL_STATE(297)] = 7304,
+  [SMALL_STATE(298)] = 7312,
+  [SMALL_STATE(299)] = 7322,
+  [SMALL_STATE(300)] = 7356,
+  [SMALL_STATE(301)] = 7363,
+  [SMALL_STATE(302)] = 7373,
+  [SMALL_STATE(303)] = 7383,
+  [SMALL_STATE(304)] = 7417,
+  [SMALL_STATE(305)] = 7427,
+  [SMALL_STATE(306)] = 7437,
+  [SMALL_STATE(307)] = 7471,
+  [SMALL_STATE(308)] = 7479,
+  [SMALL_STATE(309)] = 7513,
+  [SMALL_STATE(310)] = 7521,
+  [SMALL_STATE(311)] = 7531,
+  [SMALL_STATE(312)] = 7539,
+  [SMALL_STATE(313)] = 7549,
+  [SMALL_STATE(314)] = 7568,
+  [SMALL_STATE(315)] = 7644,
+  [SMALL_STATE(316)] = 7723,
+  [SMALL_STATE(317)] = 7734,
+  [SMALL_STATE(318)] = 7810,
+  [SMALL_STATE(319)] = 7827,
+  [SMALL_STATE(320)] = 7837,
+  [SMALL_STATE(321)] = 7913,
+  [SMALL_STATE(322)] = 7926,
+  [SMALL_STATE(323)] = 7933,
+  [SMALL_STATE(324)] = 7944,
+  [SMALL_STATE(325)] = 7966,
+  [SMALL_STATE(326)] = 7977,
+  [SMALL_STATE(327)] = 7988,
+  [SMALL_STATE(328)] = 8010,
+  [SMALL_STATE(329)] = 8021,
+  [SMALL_STATE(330)] = 8040,
+  [SMALL_STATE(331)] = 8049,
+  [SMALL_STATE(332)] = 8056,
+  [SMALL_STATE(333)] = 8076,
+  [SMALL_STATE(334)] = 8096,
+  [SMALL_STATE(335)] = 8106,
+  [SMALL_STATE(336)] = 8127,
+  [SMALL_STATE(337)] = 8147,
+  [SMALL_STATE(338)] = 8163,
+  [SMALL_STATE(339)] = 8239,
+  [SMALL_STATE(340)] = 8255,
+  [SMALL_STATE(341)] = 8262,
+  [SMALL_STATE(342)] = 8269,
+  [SMALL_STATE(343)] = 8282,
+  [SMALL_STATE(344)] = 8358,
+  [SMALL_STATE(345)] = 8377,
+  [SMALL_STATE(346)] = 8453,
+  [SMALL_STATE(347)] = 8460,
+  [SMALL_STATE(348)] = 8467,
+  [SMALL_STATE(349)] = 8475,
+  [SMALL_STATE(350)] = 8495,
+  [SMALL_STATE(351)] = 8503,
+  [SMALL_STATE(352)] = 8513,
+  [SMALL_STATE(353)] = 8523,
+  [SMALL_STATE(354)] = 8557,
+  [SMALL_STATE(355)] = 8564,
+  [SMALL_STATE(356)] = 8572,
+  [SMALL_STATE(357)] = 8582,
+  [SMALL_STATE(358)] = 8616,
+  [SMALL_STATE(359)] = 8626,
+  [SMALL_STATE(360)] = 8636,
+  [SMALL_STATE(361)] = 8670,
+  [SMALL_STATE(362)] = 8678,
+  [SMALL_STATE(363)] = 8694,
+  [SMALL_STATE(364)] = 8702,
+  [SMALL_STATE(365)] = 8712,
+  [SMALL_STATE(366)] = 8746,
+  [SMALL_STATE(367)] = 8762,
+  [SMALL_STATE(368)] = 8772,
+  [SMALL_STATE(369)] = 8782,
+  [SMALL_STATE(370)] = 8792,
+  [SMALL_STATE(371)] = 8802,
+  [SMALL_STATE(372)] = 8812,
+  [SMALL_STATE(373)] = 8822,
+  [SMALL_STATE(374)] = 8830,
+  [SMALL_STATE(375)] = 8838,
+  [SMALL_STATE(376)] = 8848,
+  [SMALL_STATE(377)] = 8856,
+  [SMALL_STATE(378)] = 8867,
+  [SMALL_STATE(379)] = 8877,
+  [SMALL_STATE(380)] = 8896,
+  [SMALL_STATE(381)] = 8907,
+  [SMALL_STATE(382)] = 8924,
+  [SMALL_STATE(383)] = 9000,
+  [SMALL_STATE(384)] = 9017,
+  [SMALL_STATE(385)] = 9024,
+  [SMALL_STATE(386)] = 9031,
+  [SMALL_STATE(387)] = 9044,
+  [SMALL_STATE(388)] = 9055,
+  [SMALL_STATE(389)] = 9066,
+  [SMALL_STATE(390)] = 9088,
+  [SMALL_STATE(391)] = 9099,
+  [SMALL_STATE(392)] = 9108,
+  [SMALL_STATE(393)] = 9128,
+  [SMALL_STATE(394)] = 9144,
+  [SMALL_STATE(395)] = 9220,
+  [SMALL_STATE(396)] = 9296,
+  [SMALL_STATE(397)] = 9303,
+  [SMALL_STATE(398)] = 9310,
+  [SMALL_STATE(399)] = 9395,
+  [SMALL_STATE(400)] = 9408,
+  [SMALL_STATE(401)] = 9484,
+  [SMALL_STATE(402)] = 9511,
+  [SMALL_STATE(403)] = 9521,
+  [SMALL_STATE(404)] = 9548,
+  [SMALL_STATE(405)] = 9624,
+  [SMALL_STATE(406)] = 9700,
+  [SMALL_STATE(407)] = 9708,
+  [SMALL_STATE(408)] = 9716,
+  [SMALL_STATE(409)] = 9726,
+  [SMALL_STATE(410)] = 9736,
+  [SMALL_STATE(411)] = 9770,
+  [SMALL_STATE(412)] = 9786,
+  [SMALL_STATE(413)] = 9794,
+  [SMALL_STATE(414)] = 9804,
+  [SMALL_STATE(415)] = 9812,
+  [SMALL_STATE(416)] = 9822,
+  [SMALL_STATE(417)] = 9830,
+  [SMALL_STATE(418)] = 9840,
+  [SMALL_STATE(419)] = 9848,
+  [SMALL_STATE(420)] = 9858,
+  [SMALL_STATE(421)] = 9868,
+  [SMALL_STATE(422)] = 9878,
+  [SMALL_STATE(423)] = 9888,
+  [SMALL_STATE(424)] = 9904,
+  [SMALL_STATE(425)] = 9914,
+  [SMALL_STATE(426)] = 9924,
+  [SMALL_STATE(427)] = 9934,
+  [SMALL_STATE(428)] = 9944,
+  [SMALL_STATE(429)] = 9952,
+  [SMALL_STATE(430)] = 9963,
+  [SMALL_STATE(431)] = 9974,
+  [SMALL_STATE(432)] = 9984,
+  [SMALL_STATE(433)] = 10001,
+  [SMALL_STATE(434)] = 10077,
+  [SMALL_STATE(435)] = 10153,
+  [SMALL_STATE(436)] = 10160,
+  [SMALL_STATE(437)] = 10167,
+  [SMALL_STATE(438)] = 10178,
+  [SMALL_STATE(439)] = 10258,
+  [SMALL_STATE(440)] = 10271,
+  [SMALL_STATE(441)] = 10347,
+  [SMALL_STATE(442)] = 10369,
+  [SMALL_STATE(443)] = 10391,
+  [SMALL_STATE(444)] = 10467,
+  [SMALL_STATE(445)] = 10543,
+  [SMALL_STATE(446)] = 10553,
+  [SMALL_STATE(447)] = 10629,
+  [SMALL_STATE(448)] = 10636,
+  [SMALL_STATE(449)] = 10652,
+  [SMALL_STATE(450)] = 10728,
+  [SMALL_STATE(451)] = 10749,
+  [SMALL_STATE(452)] = 10825,
+  [SMALL_STATE(453)] = 10904,
+  [SMALL_STATE(454)] = 10911,
+  [SMALL_STATE(455)] = 10932,
+  [SMALL_STATE(456)] = 11008,
+  [SMALL_STATE(457)] = 11035,
+  [SMALL_STATE(458)] = 11062,
+  [SMALL_STATE(459)] = 11070,
+  [SMALL_STATE(460)] = 11078,
+  [SMALL_STATE(461)] = 11088,
+  [SMALL_STATE(462)] = 11098,
+  [SMALL_STATE(463)] = 11106,
+  [SMALL_STATE(464)] = 11122,
+  [SMALL_STATE(465)] = 11130,
+  [SMALL_STATE(466)] = 11138,
+  [SMALL_STATE(467)] = 11148,
+  [SMALL_STATE(468)] = 11156,
+  [SMALL_STATE(469)] = 11166,
+  [SMALL_STATE(470)] = 11176,
+  [SMALL_STATE(471)] = 11186,
+  [SMALL_STATE(472)] = 11196,
+  [SMALL_STATE(473)] = 11206,
+  [SMALL_STATE(474)] = 11217,
+  [SMALL_STATE(475)] = 11232,
+  [SMALL_STATE(476)] = 11247,
+  [SMALL_STATE(477)] = 11263,
+  [SMALL_STATE(478)] = 11276,
+  [SMALL_STATE(479)] = 11354,
+  [SMALL_STATE(480)] = 11369,
+  [SMALL_STATE(481)] = 11469,
+  [SMALL_STATE(482)] = 11482,
+  [SMALL_STATE(483)] = 11558,
+  [SMALL_STATE(484)] = 11640,
+  [SMALL_STATE(485)] = 11647,
+  [SMALL_STATE(486)] = 11670,
+  [SMALL_STATE(487)] = 11685,
+  [SMALL_STATE(488)] = 11700,
+  [SMALL_STATE(489)] = 11715,
+  [SMALL_STATE(490)] = 11730,
+  [SMALL_STATE(491)] = 11745,
+  [SMALL_STATE(492)] = 11760,
+  [SMALL_STATE(493)] = 11775,
+  [SMALL_STATE(494)] = 11790,
+  [SMALL_STATE(495)] = 11805,
+  [SMALL_STATE(496)] = 11820,
+  [SMALL_STATE(497)] = 11835,
+  [SMALL_STATE(498)] = 11850,
+  [SMALL_STATE(499)] = 11873,
+  [SMALL_STATE(500)] = 11949,
+  [SMALL_STATE(501)] = 12025,
+  [SMALL_STATE(502)] = 12035,
+  [SMALL_STATE(503)] = 12111,
+  [SMALL_STATE(504)] = 12118,
+  [SMALL_STATE(505)] = 12134,
+  [SMALL_STATE(506)] = 12210,
+  [SMALL_STATE(507)] = 12228,
+  [SMALL_STATE(508)] = 12304,
+  [SMALL_STATE(509)] = 12322,
+  [SMALL_STATE(510)] = 12398,
+  [SMALL_STATE(511)] = 12420,
+  [SMALL_STATE(512)] = 12442,
+  [SMALL_STATE(513)] = 12452,
+  [SMALL_STATE(514)] = 12528,
+  [SMALL_STATE(515)] = 12553,
+  [SMALL_STATE(516)] = 12563,
+  [SMALL_STATE(517)] = 12573,
+  [SMALL_STATE(518)] = 12649,
+  [SMALL_STATE(519)] = 12670,
+  [SMALL_STATE(520)] = 12677,
+  [SMALL_STATE(521)] = 12753,
+  [SMALL_STATE(522)] = 12774,
+  [SMALL_STATE(523)] = 12795,
+  [SMALL_STATE(524)] = 12871,
+  [SMALL_STATE(525)] = 12892,
+  [SMALL_STATE(526)] = 12968,
+  [SMALL_STATE(527)] = 12976,
+  [SMALL_STATE(528)] = 12984,
+  [SMALL_STATE(529)] = 12994,
+  [SMALL_STATE(530)] = 13002,
+  [SMALL_STATE(531)] = 13010,
+  [SMALL_STATE(532)] = 13020,
+  [SMALL_STATE(533)] = 13035,
+  [SMALL_STATE(534)] = 13048,
+  [SMALL_STATE(535)] = 13055,
+  [SMALL_STATE(536)] = 13070,
+  [SMALL_STATE(537)] = 13077,
+  [SMALL_STATE(538)] = 13092,
+  [SMALL_STATE(539)] = 13114,
+  [SMALL_STATE(540)] = 13121,
+  [SMALL_STATE(541)] = 13131,
+  [SMALL_STATE(542)] = 13207,
+  [SMALL_STATE(543)] = 13214,
+  [SMALL_STATE(544)] = 13230,
+  [SMALL_STATE(545)] = 13245,
+  [SMALL_STATE(546)] = 13264,
+  [SMALL_STATE(547)] = 13340,
+  [SMALL_STATE(548)] = 13356,
+  [SMALL_STATE(549)] = 13432,
+  [SMALL_STATE(550)] = 13514,
+  [SMALL_STATE(551)] = 13521,
+  [SMALL_STATE(552)] = 13538,
+  [SMALL_STATE(553)] = 13614,
+  [SMALL_STATE(554)] = 13631,
+  [SMALL_STATE(555)] = 13707,
+  [SMALL_STATE(556)] = 13730,
+  [SMALL_STATE(557)] = 13753,
+  [SMALL_STATE(558)] = 13763,
+  [SMALL_STATE(559)] = 13839,
+  [SMALL_STATE(560)] = 13859,
+  [SMALL_STATE(561)] = 13869,
+  [SMALL_STATE(562)] = 13879,
+  [SMALL_STATE(563)] = 13955,
+  [SMALL_STATE(564)] = 13971,
+  [SMALL_STATE(565)] = 14047,
+  [SMALL_STATE(566)] = 14063,
+  [SMALL_STATE(567)] = 14081,
+  [SMALL_STATE(568)] = 14157,
+  [SMALL_STATE(569)] = 14175,
+  [SMALL_STATE(570)] = 14251,
+  [SMALL_STATE(571)] = 14327,
+  [SMALL_STATE(572)] = 14352,
+  [SMALL_STATE(573)] = 14362,
+  [SMALL_STATE(574)] = 14438,
+  [SMALL_STATE(575)] = 14451,
+  [SMALL_STATE(576)] = 14458,
+  [SMALL_STATE(577)] = 14479,
+  [SMALL_STATE(578)] = 14500,
+  [SMALL_STATE(579)] = 14576,
+  [SMALL_STATE(580)] = 14597,
+  [SMALL_STATE(581)] = 14673,
+  [SMALL_STATE(582)] = 14694,
+  [SMALL_STATE(583)] = 14702,
+  [SMALL_STATE(584)] = 14717,
+  [SMALL_STATE(585)] = 14793,
+  [SMALL_STATE(586)] = 14800,
+  [SMALL_STATE(587)] = 14810,
+  [SMALL_STATE(588)] = 14825,
+  [SMALL_STATE(589)] = 14835,
+  [SMALL_STATE(590)] = 14911,
+  [SMALL_STATE(591)] = 14932,
+  [SMALL_STATE(592)] = 14942,
+  [SMALL_STATE(593)] = 14952,
+  [SMALL_STATE(594)] = 14974,
+  [SMALL_STATE(595)] = 14989,
+  [SMALL_STATE(596)] = 15008,
+  [SMALL_STATE(597)] = 15108,
+  [SMALL_STATE(598)] = 15123,
+  [SMALL_STATE(599)] = 15136,
+  [SMALL_STATE(600)] = 15155,
+  [SMALL_STATE(601)] = 15170,
+  [SMALL_STATE(602)] = 15189,
+  [SMALL_STATE(603)] = 15265,
+  [SMALL_STATE(604)] = 15280,
+  [SMALL_STATE(605)] = 15356,
+  [SMALL_STATE(606)] = 15373,
+  [SMALL_STATE(607)] = 15449,
+  [SMALL_STATE(608)] = 15466,
+  [SMALL_STATE(609)] = 15483,
+  [SMALL_STATE(610)] = 15559,
+  [SMALL_STATE(611)] = 15576,
+  [SMALL_STATE(612)] = 15652,
+  [SMALL_STATE(613)] = 15728,
+  [SMALL_STATE(614)] = 15748,
+  [SMALL_STATE(615)] = 15758,
+  [SMALL_STATE(616)] = 15834,
+  [SMALL_STATE(617)] = 15847,
+  [SMALL_STATE(618)] = 15854,
+  [SMALL_STATE(619)] = 15870,
+  [SMALL_STATE(620)] = 15886,
+  [SMALL_STATE(621)] = 15962,
+  [SMALL_STATE(622)] = 15978,
+  [SMALL_STATE(623)] = 16054,
+  [SMALL_STATE(624)] = 16070,
+  [SMALL_STATE(625)] = 16095,
+  [SMALL_STATE(626)] = 16171,
+  [SMALL_STATE(627)] = 16196,
+  [SMALL_STATE(628)] = 16203,
+  [SMALL_STATE(629)] = 16210,
+  [SMALL_STATE(630)] = 16223,
+  [SMALL_STATE(631)] = 16244,
+  [SMALL_STATE(632)] = 16265,
+  [SMALL_STATE(633)] = 16284,
+  [SMALL_STATE(634)] = 16360,
+  [SMALL_STATE(635)] = 16439,
+  [SMALL_STATE(636)] = 16454,
+  [SMALL_STATE(637)] = 16530,
+  [SMALL_STATE(638)] = 16551,
+  [SMALL_STATE(639)] = 16561,
+  [SMALL_STATE(640)] = 16637,
+  [SMALL_STATE(641)] = 16650,
+  [SMALL_STATE(642)] = 16657,
+  [SMALL_STATE(643)] = 16672,
+  [SMALL_STATE(644)] = 16694,
+  [SMALL_STATE(645)] = 16709,
+  [SMALL_STATE(646)] = 16724,
+  [SMALL_STATE(647)] = 16746,
+  [SMALL_STATE(648)] = 16761,
+  [SMALL_STATE(649)] = 16780,
+  [SMALL_STATE(650)] = 16797,
+  [SMALL_STATE(651)] = 16814,
+  [SMALL_STATE(652)] = 16890,
+  [SMALL_STATE(653)] = 16907,
+  [SMALL_STATE(654)] = 16983,
+  [SMALL_STATE(655)] = 17000,
+  [SMALL_STATE(656)] = 17020,
+  [SMALL_STATE(657)] = 17096,
+  [SMALL_STATE(658)] = 17116,
+  [SMALL_STATE(659)] = 17123,
+  [SMALL_STATE(660)] = 17130,
+  [SMALL_STATE(661)] = 17143,
+  [SMALL_STATE(662)] = 17159,
+  [SMALL_STATE(663)] = 17175,
+  [SMALL_STATE(664)] = 17200,
+  [SMALL_STATE(665)] = 17276,
+  [SMALL_STATE(666)] = 17352,
+  [SMALL_STATE(667)] = 17359,
+  [SMALL_STATE(668)] = 17366,
+  [SMALL_STATE(669)] = 17381,
+  [SMALL_STATE(670)] = 17391,
+  [SMALL_STATE(671)] = 17410,
+  [SMALL_STATE(672)] = 17425,
+  [SMALL_STATE(673)] = 17446,
+  [SMALL_STATE(674)] = 17522,
+  [SMALL_STATE(675)] = 17543,
+  [SMALL_STATE(676)] = 17550,
+  [SMALL_STATE(677)] = 17557,
+  [SMALL_STATE(678)] = 17570,
+  [SMALL_STATE(679)] = 17585,
+  [SMALL_STATE(680)] = 17600,
+  [SMALL_STATE(681)] = 17622,
+  [SMALL_STATE(682)] = 17637,
+  [SMALL_STATE(683)] = 17654,
+  [SMALL_STATE(684)] = 17671,
+  [SMALL_STATE(685)] = 17691,
+  [SMALL_STATE(686)] = 17767,
+  [SMALL_STATE(687)] = 17843,
+  [SMALL_STATE(688)] = 17850,
+  [SMALL_STATE(689)] = 17857,
+  [SMALL_STATE(690)] = 17882,
+  [SMALL_STATE(691)] = 17907,
+  [SMALL_STATE(692)] = 17983,
+  [SMALL_STATE(693)] = 18059,
+  [SMALL_STATE(694)] = 18074,
+  [SMALL_STATE(695)] = 18089,
+  [SMALL_STATE(696)] = 18099,
+  [SMALL_STATE(697)] = 18120,
+  [SMALL_STATE(698)] = 18196,
+  [SMALL_STATE(699)] = 18272,
+  [SMALL_STATE(700)] = 18279,
+  [SMALL_STATE(701)] = 18286,
+  [SMALL_STATE(702)] = 18301,
+  [SMALL_STATE(703)] = 18323,
+  [SMALL_STATE(704)] = 18345,
+  [SMALL_STATE(705)] = 18421,
+  [SMALL_STATE(706)] = 18497,
+  [SMALL_STATE(707)] = 18573,
+  [SMALL_STATE(708)] = 18649,
+  [SMALL_STATE(709)] = 18674,
+  [SMALL_STATE(710)] = 18699,
+  [SMALL_STATE(711)] = 18714,
+  [SMALL_STATE(712)] = 18735,
+  [SMALL_STATE(713)] = 18756,
+  [SMALL_STATE(714)] = 18832,
+  [SMALL_STATE(715)] = 18908,
+  [SMALL_STATE(716)] = 18984,
+  [SMALL_STATE(717)] = 19060,
+  [SMALL_STATE(718)] = 19082,
+  [SMALL_STATE(719)] = 19104,
+  [SMALL_STATE(720)] = 19129,
+  [SMALL_STATE(721)] = 19154,
+  [SMALL_STATE(722)] = 19230,
+  [SMALL_STATE(723)] = 19306,
+  [SMALL_STATE(724)] = 19382,
+  [SMALL_STATE(725)] = 19458,
+  [SMALL_STATE(726)] = 19479,
+  [SMALL_STATE(727)] = 19500,
+  [SMALL_STATE(728)] = 19520,
+  [SMALL_STATE(729)] = 19540,
+  [SMALL_STATE(730)] = 19616,
+  [SMALL_STATE(731)] = 19692,
+  [SMALL_STATE(732)] = 19717,
+  [SMALL_STATE(733)] = 19742,
+  [SMALL_STATE(734)] = 19763,
+  [SMALL_STATE(735)] = 19784,
+  [SMALL_STATE(736)] = 19860,
+  [SMALL_STATE(737)] = 19936,
+  [SMALL_STATE(738)] = 19956,
+  [SMALL_STATE(739)] = 19976,
+  [SMALL_STATE(740)] = 19997,
 };
 
 static const TSParseActionEntry ts_parse_actions[] = {
   [0] = {.entry = {.count = 0, .reusable = false}},
   [1] = {.entry = {.count = 1, .reusable = false}}, RECOVER(),
   [3] = {.entry = {.count = 1, .reusable = true}}, SHIFT_EXTRA(),
-  [5] = {.entry = {.count = 1, .reusable = false}}, SHIFT(130),
-  [7] = {.entry = {.count = 1, .reusable = true}}, SHIFT(192),
-  [9] = {.entry = {.count = 1, .reusable = true}}, SHIFT(216),
-  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(220),
-  [13] = {.entry = {.count = 1, .reusable = true}}, SHIFT(5),
-  [15] = {.entry = {.count = 1, .reusable = true}}, SHIFT(3),
-  [17] = {.entry = {.count = 1, .reusable = true}}, SHIFT(240),
-  [19] = {.entry = {.count = 1, .reusable = false}}, SHIFT(48),
-  [21] = {.entry = {.count = 1, .reusable = true}}, SHIFT(8),
-  [23] = {.entry = {.count = 1, .reusable = false}}, SHIFT(350),
-  [25] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_cons, 1, 0, 0),
-  [27] = {.entry = {.count = 1, .reusable = false}}, SHIFT(80),
-  [29] = {.entry = {.count = 1, .reusable = true}}, SHIFT(80),
-  [31] = {.entry = {.count = 1, .reusable = true}}, SHIFT(2),
-  [33] = {.entry = {.count = 1, .reusable = true}}, SHIFT(242),
-  [35] = {.entry = {.count = 1, .reusable = false}}, SHIFT(54),
-  [37] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_cons, 1, 0, 0),
-  [39] = {.entry = {.count = 1, .reusable = true}}, SHIFT(180),
-  [41] = {.entry = {.count = 1, .reusable = false}}, SHIFT(147),
-  [43] = {.entry = {.count = 1, .reusable = false}}, SHIFT(210),
-  [45] = {.entry = {.count = 1, .reusable = false}}, SHIFT(225),
-  [47] = {.entry = {.count = 1, .reusable = false}}, SHIFT(7),
-  [49] = {.entry = {.count = 1, .reusable = false}}, SHIFT(53),
-  [51] = {.entry = {.count = 1, .reusable = false}}, SHIFT(11),
-  [53] = {.entry = {.count = 1, .reusable = false}}, SHIFT_EXTRA(),
-  [55] = {.entry = {.count = 1, .reusable = false}}, SHIFT(201),
-  [57] = {.entry = {.count = 1, .reusable = true}}, SHIFT(201),
-  [59] = {.entry = {.count = 1, .reusable = true}}, SHIFT(86),
-  [61] = {.entry = {.count = 1, .reusable = false}}, SHIFT(200),
-  [63] = {.entry = {.count = 1, .reusable = true}}, SHIFT(200),
-  [65] = {.entry = {.count = 1, .reusable = true}}, SHIFT(105),
-  [67] = {.entry = {.count = 1, .reusable = false}}, SHIFT(10),
-  [69] = {.entry = {.count = 1, .reusable = false}}, SHIFT(52),
-  [71] = {.entry = {.count = 1, .reusable = false}}, SHIFT(174),
-  [73] = {.entry = {.count = 1, .reusable = true}}, SHIFT(174),
-  [75] = {.entry = {.count = 1, .reusable = true}}, SHIFT(148),
-  [77] = {.entry = {.count = 1, .reusable = false}}, SHIFT(184),
-  [79] = {.entry = {.count = 1, .reusable = true}}, SHIFT(184),
-  [81] = {.entry = {.count = 1, .reusable = true}}, SHIFT(153),
-  [83] = {.entry = {.count = 1, .reusable = false}}, SHIFT(202),
-  [85] = {.entry = {.count = 1, .reusable = true}}, SHIFT(202),
-  [87] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(215),
-  [90] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(215),
-  [93] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(216),
-  [96] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(220),
-  [99] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(5),
-  [102] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(3),
-  [105] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(240),
-  [108] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(48),
-  [111] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0), SHIFT_REPEAT(8),
-  [114] = {.entry = {.count = 1, .reusable = false}}, SHIFT(186),
-  [116] = {.entry = {.count = 1, .reusable = true}}, SHIFT(186),
-  [118] = {.entry = {.count = 1, .reusable = false}}, SHIFT(183),
-  [120] = {.entry = {.count = 1, .reusable = true}}, SHIFT(183),
-  [122] = {.entry = {.count = 1, .reusable = false}}, SHIFT(187),
-  [124] = {.entry = {.count = 1, .reusable = true}}, SHIFT(187),
-  [126] = {.entry = {.count = 1, .reusable = false}}, SHIFT(152),
-  [128] = {.entry = {.count = 1, .reusable = true}}, SHIFT(152),
-  [130] = {.entry = {.count = 1, .reusable = true}}, SHIFT(210),
-  [132] = {.entry = {.count = 1, .reusable = true}}, SHIFT(225),
-  [134] = {.entry = {.count = 1, .reusable = true}}, SHIFT(10),
-  [136] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
-  [138] = {.entry = {.count = 1, .reusable = true}}, SHIFT(226),
-  [140] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
-  [142] = {.entry = {.count = 1, .reusable = false}}, SHIFT(144),
-  [144] = {.entry = {.count = 1, .reusable = true}}, SHIFT(144),
-  [146] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
-  [148] = {.entry = {.count = 1, .reusable = true}}, SHIFT(245),
-  [150] = {.entry = {.count = 1, .reusable = false}}, SHIFT(177),
-  [152] = {.entry = {.count = 1, .reusable = true}}, SHIFT(177),
-  [154] = {.entry = {.count = 1, .reusable = false}}, SHIFT(118),
-  [156] = {.entry = {.count = 1, .reusable = true}}, SHIFT(118),
-  [158] = {.entry = {.count = 1, .reusable = false}}, SHIFT(213),
-  [160] = {.entry = {.count = 1, .reusable = true}}, SHIFT(213),
-  [162] = {.entry = {.count = 1, .reusable = false}}, SHIFT(191),
-  [164] = {.entry = {.count = 1, .reusable = true}}, SHIFT(191),
-  [166] = {.entry = {.count = 1, .reusable = false}}, SHIFT(120),
-  [168] = {.entry = {.count = 1, .reusable = true}}, SHIFT(120),
-  [170] = {.entry = {.count = 1, .reusable = false}}, SHIFT(209),
-  [172] = {.entry = {.count = 1, .reusable = true}}, SHIFT(209),
-  [174] = {.entry = {.count = 1, .reusable = false}}, SHIFT(117),
-  [176] = {.entry = {.count = 1, .reusable = true}}, SHIFT(117),
-  [178] = {.entry = {.count = 1, .reusable = false}}, SHIFT(98),
-  [180] = {.entry = {.count = 1, .reusable = true}}, SHIFT(98),
-  [182] = {.entry = {.count = 1, .reusable = false}}, SHIFT(106),
-  [184] = {.entry = {.count = 1, .reusable = true}}, SHIFT(106),
-  [186] = {.entry = {.count = 1, .reusable = false}}, SHIFT(122),
-  [188] = {.entry = {.count = 1, .reusable = true}}, SHIFT(122),
-  [190] = {.entry = {.count = 1, .reusable = false}}, SHIFT(203),
-  [192] = {.entry = {.count = 1, .reusable = true}}, SHIFT(203),
-  [194] = {.entry = {.count = 1, .reusable = false}}, SHIFT(125),
-  [196] = {.entry = {.count = 1, .reusable = true}}, SHIFT(125),
-  [198] = {.entry = {.count = 1, .reusable = false}}, SHIFT(116),
-  [200] = {.entry = {.count = 1, .reusable = true}}, SHIFT(116),
-  [202] = {.entry = {.count = 1, .reusable = false}}, SHIFT(198),
-  [204] = {.entry = {.count = 1, .reusable = true}}, SHIFT(198),
-  [206] = {.entry = {.count = 1, .reusable = false}}, SHIFT(195),
-  [208] = {.entry = {.count = 1, .reusable = true}}, SHIFT(195),
-  [210] = {.entry = {.count = 1, .reusable = false}}, SHIFT(175),
-  [212] = {.entry = {.count = 1, .reusable = true}}, SHIFT(175),
-  [214] = {.entry = {.count = 1, .reusable = false}}, SHIFT(181),
-  [216] = {.entry = {.count = 1, .reusable = true}}, SHIFT(181),
-  [218] = {.entry = {.count = 1, .reusable = false}}, SHIFT(199),
-  [220] = {.entry = {.count = 1, .reusable = true}}, SHIFT(199),
-  [222] = {.entry = {.count = 1, .reusable = false}}, SHIFT(162),
-  [224] = {.entry = {.count = 1, .reusable = true}}, SHIFT(162),
-  [226] = {.entry = {.count = 1, .reusable = false}}, SHIFT(164),
-  [228] = {.entry = {.count = 1, .reusable = true}}, SHIFT(164),
-  [230] = {.entry = {.count = 1, .reusable = false}}, SHIFT(176),
-  [232] = {.entry = {.count = 1, .reusable = true}}, SHIFT(176),
-  [234] = {.entry = {.count = 1, .reusable = false}}, SHIFT(178),
-  [236] = {.entry = {.count = 1, .reusable = true}}, SHIFT(178),
-  [238] = {.entry = {.count = 1, .reusable = false}}, SHIFT(179),
-  [240] = {.entry = {.count = 1, .reusable = true}}, SHIFT(179),
-  [242] = {.entry = {.count = 1, .reusable = false}}, SHIFT(123),
-  [244] = {.entry = {.count = 1, .reusable = true}}, SHIFT(123),
-  [246] = {.entry = {.count = 1, .reusable = false}}, SHIFT(78),
-  [248] = {.entry = {.count = 1, .reusable = true}}, SHIFT(78),
-  [250] = {.entry = {.count = 1, .reusable = false}}, SHIFT(79),
-  [252] = {.entry = {.count = 1, .reusable = true}}, SHIFT(79),
-  [254] = {.entry = {.count = 1, .reusable = false}}, SHIFT(166),
-  [256] = {.entry = {.count = 1, .reusable = true}}, SHIFT(166),
-  [258] = {.entry = {.count = 1, .reusable = false}}, SHIFT(167),
-  [260] = {.entry = {.count = 1, .reusable = true}}, SHIFT(167),
-  [262] = {.entry = {.count = 1, .reusable = false}}, SHIFT(212),
-  [264] = {.entry = {.count = 1, .reusable = true}}, SHIFT(212),
-  [266] = {.entry = {.count = 1, .reusable = false}}, SHIFT(185),
-  [268] = {.entry = {.count = 1, .reusable = true}}, SHIFT(185),
-  [270] = {.entry = {.count = 1, .reusable = false}}, SHIFT(101),
-  [272] = {.entry = {.count = 1, .reusable = true}}, SHIFT(101),
-  [274] = {.entry = {.count = 1, .reusable = false}}, SHIFT(188),
-  [276] = {.entry = {.count = 1, .reusable = true}}, SHIFT(188),
-  [278] = {.entry = {.count = 1, .reusable = false}}, SHIFT(205),
-  [280] = {.entry = {.count = 1, .reusable = true}}, SHIFT(205),
-  [282] = {.entry = {.count = 1, .reusable = false}}, SHIFT(206),
-  [284] = {.entry = {.count = 1, .reusable = true}}, SHIFT(206),
-  [286] = {.entry = {.count = 1, .reusable = false}}, SHIFT(207),
-  [288] = {.entry = {.count = 1, .reusable = true}}, SHIFT(207),
-  [290] = {.entry = {.count = 1, .reusable = false}}, SHIFT(92),
-  [292] = {.entry = {.count = 1, .reusable = true}}, SHIFT(92),
-  [294] = {.entry = {.count = 1, .reusable = false}}, SHIFT(94),
-  [296] = {.entry = {.count = 1, .reusable = true}}, SHIFT(94),
-  [298] = {.entry = {.count = 1, .reusable = false}}, SHIFT(95),
-  [300] = {.entry = {.count = 1, .reusable = true}}, SHIFT(95),
-  [302] = {.entry = {.count = 1, .reusable = false}}, SHIFT(97),
-  [304] = {.entry = {.count = 1, .reusable = true}}, SHIFT(97),
-  [306] = {.entry = {.count = 1, .reusable = false}}, SHIFT(99),
-  [308] = {.entry = {.count = 1, .reusable = true}}, SHIFT(99),
-  [310] = {.entry = {.count = 1, .reusable = false}}, SHIFT(100),
-  [312] = {.entry = {.count = 1, .reusable = true}}, SHIFT(100),
-  [314] = {.entry = {.count = 1, .reusable = false}}, SHIFT(102),
-  [316] = {.entry = {.count = 1, .reusable = true}}, SHIFT(102),
-  [318] = {.entry = {.count = 1, .reusable = false}}, SHIFT(168),
-  [320] = {.entry = {.count = 1, .reusable = true}}, SHIFT(168),
-  [322] = {.entry = {.count = 1, .reusable = false}}, SHIFT(169),
-  [324] = {.entry = {.count = 1, .reusable = true}}, SHIFT(169),
-  [326] = {.entry = {.count = 1, .reusable = false}}, SHIFT(170),
-  [328] = {.entry = {.count = 1, .reusable = true}}, SHIFT(170),
-  [330] = {.entry = {.count = 1, .reusable = false}}, SHIFT(171),
-  [332] = {.entry = {.count = 1, .reusable = true}}, SHIFT(171),
-  [334] = {.entry = {.count = 1, .reusable = false}}, SHIFT(172),
-  [336] = {.entry = {.count = 1, .reusable = true}}, SHIFT(172),
-  [338] = {.entry = {.count = 1, .reusable = false}}, SHIFT(173),
-  [340] = {.entry = {.count = 1, .reusable = true}}, SHIFT(173),
-  [342] = {.entry = {.count = 1, .reusable = false}}, SHIFT(196),
-  [344] = {.entry = {.count = 1, .reusable = true}}, SHIFT(196),
-  [346] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym__inner_block_repeat1, 2, 0, 0), SHIFT_REPEAT(222),
-  [349] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym__inner_block_repeat1, 2, 0, 0),
-  [351] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym__inner_block_repeat1, 2, 0, 0),
-  [353] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym__inner_block_repeat1, 2, 0, 0), SHIFT_REPEAT(350),
-  [356] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rec, 6, 0, 0),
-  [358] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0),
-  [360] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0),
-  [362] = {.entry = {.count = 1, .reusable = true}}, SHIFT(87),
-  [364] = {.entry = {.count = 1, .reusable = true}}, SHIFT(111),
-  [366] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_app, 4, 0, 0),
-  [368] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rec, 5, 0, 0),
-  [370] = {.entry = {.count = 1, .reusable = true}}, SHIFT(88),
-  [372] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 7, 0, 0),
-  [374] = {.entry = {.count = 1, .reusable = true}}, SHIFT(56),
-  [376] = {.entry = {.count = 1, .reusable = true}}, SHIFT(9),
-  [378] = {.entry = {.count = 1, .reusable = true}}, SHIFT(393),
-  [380] = {.entry = {.count = 1, .reusable = true}}, SHIFT(60),
-  [382] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 8, 0, 0),
-  [384] = {.entry = {.count = 1, .reusable = true}}, SHIFT(61),
-  [386] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_cons, 2, 0, 0),
-  [388] = {.entry = {.count = 1, .reusable = true}}, SHIFT(155),
-  [390] = {.entry = {.count = 1, .reusable = true}}, SHIFT(157),
-  [392] = {.entry = {.count = 1, .reusable = true}}, SHIFT(158),
-  [394] = {.entry = {.count = 1, .reusable = true}}, SHIFT(160),
-  [396] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 3, 0, 0),
-  [398] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 2, 0, 0),
-  [400] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 5, 0, 0),
-  [402] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 4, 0, 0),
-  [404] = {.entry = {.count = 1, .reusable = true}}, SHIFT(93),
-  [406] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_str, 3, 0, 0),
-  [408] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_block, 3, 0, 0),
-  [410] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_lam, 3, 0, 0),
-  [412] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_app, 6, 0, 0),
-  [414] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_lam, 4, 0, 0),
-  [416] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_lam, 5, 0, 0),
-  [418] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rec, 7, 0, 0),
-  [420] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_lam, 6, 0, 0),
-  [422] = {.entry = {.count = 1, .reusable = true}}, SHIFT(104),
-  [424] = {.entry = {.count = 1, .reusable = true}}, SHIFT(29),
-  [426] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 9, 0, 0),
-  [428] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 10, 0, 0),
-  [430] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_iapp, 3, 0, 0),
-  [432] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 11, 0, 0),
-  [434] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_str, 2, 0, 0),
-  [436] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_when_repeat1, 5, 0, 0),
-  [438] = {.entry = {.count = 1, .reusable = true}}, SHIFT(31),
-  [440] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_app, 3, 0, 0),
-  [442] = {.entry = {.count = 1, .reusable = true}}, SHIFT(109),
-  [444] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rec, 2, 0, 0),
-  [446] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_prop, 3, 0, 0),
-  [448] = {.entry = {.count = 1, .reusable = true}}, SHIFT(32),
-  [450] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_app, 5, 0, 0),
-  [452] = {.entry = {.count = 1, .reusable = true}}, SHIFT(291),
-  [454] = {.entry = {.count = 1, .reusable = true}}, SHIFT(233),
-  [456] = {.entry = {.count = 1, .reusable = true}}, SHIFT(221),
-  [458] = {.entry = {.count = 1, .reusable = true}}, SHIFT(234),
-  [460] = {.entry = {.count = 1, .reusable = true}}, SHIFT(290),
-  [462] = {.entry = {.count = 1, .reusable = true}}, SHIFT(277),
-  [464] = {.entry = {.count = 1, .reusable = true}}, SHIFT(232),
-  [466] = {.entry = {.count = 1, .reusable = true}}, SHIFT(260),
-  [468] = {.entry = {.count = 1, .reusable = true}}, SHIFT(328),
-  [470] = {.entry = {.count = 1, .reusable = true}}, SHIFT(50),
-  [472] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_type_cons_repeat1, 2, 0, 0), SHIFT_REPEAT(389),
-  [475] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_type_cons_repeat1, 2, 0, 0), SHIFT_REPEAT(233),
-  [478] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_type_cons_repeat1, 2, 0, 0), SHIFT_REPEAT(221),
-  [481] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_type_cons_repeat1, 2, 0, 0), SHIFT_REPEAT(234),
-  [484] = {.entry = {.count = 1, .reusable = true}}, SHIFT(318),
-  [486] = {.entry = {.count = 1, .reusable = true}}, SHIFT(266),
-  [488] = {.entry = {.count = 1, .reusable = true}}, SHIFT(280),
-  [490] = {.entry = {.count = 1, .reusable = true}}, SHIFT(340),
-  [492] = {.entry = {.count = 1, .reusable = true}}, SHIFT(223),
-  [494] = {.entry = {.count = 1, .reusable = true}}, SHIFT(214),
-  [496] = {.entry = {.count = 1, .reusable = true}}, SHIFT(224),
-  [498] = {.entry = {.count = 1, .reusable = true}}, SHIFT(284),
-  [500] = {.entry = {.count = 1, .reusable = true}}, SHIFT(296),
-  [502] = {.entry = {.count = 1, .reusable = true}}, SHIFT(227),
-  [504] = {.entry = {.count = 1, .reusable = true}}, SHIFT(228),
-  [506] = {.entry = {.count = 1, .reusable = true}}, SHIFT(229),
-  [508] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__expr, 1, 0, 0),
-  [510] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym__expr, 1, 0, 0),
-  [512] = {.entry = {.count = 1, .reusable = true}}, SHIFT(129),
-  [514] = {.entry = {.count = 1, .reusable = false}}, SHIFT(34),
-  [516] = {.entry = {.count = 1, .reusable = false}}, SHIFT(70),
-  [518] = {.entry = {.count = 1, .reusable = true}}, SHIFT(321),
-  [520] = {.entry = {.count = 1, .reusable = true}}, SHIFT(395),
-  [522] = {.entry = {.count = 1, .reusable = true}}, SHIFT(282),
-  [524] = {.entry = {.count = 1, .reusable = true}}, SHIFT(355),
-  [526] = {.entry = {.count = 1, .reusable = true}}, SHIFT(315),
-  [528] = {.entry = {.count = 1, .reusable = true}}, SHIFT(264),
-  [530] = {.entry = {.count = 1, .reusable = true}}, SHIFT(293),
-  [532] = {.entry = {.count = 1, .reusable = true}}, SHIFT(268),
-  [534] = {.entry = {.count = 1, .reusable = true}}, SHIFT(271),
-  [536] = {.entry = {.count = 1, .reusable = true}}, SHIFT(278),
-  [538] = {.entry = {.count = 1, .reusable = true}}, SHIFT(261),
-  [540] = {.entry = {.count = 1, .reusable = true}}, SHIFT(279),
-  [542] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_app, 4, 0, 0),
-  [544] = {.entry = {.count = 1, .reusable = false}}, SHIFT(64),
-  [546] = {.entry = {.count = 1, .reusable = false}}, SHIFT(12),
-  [548] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 11, 0, 0),
-  [550] = {.entry = {.count = 1, .reusable = false}}, SHIFT(351),
-  [552] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_str, 2, 0, 0),
-  [554] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_rec, 2, 0, 0),
-  [556] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_cons, 2, 0, 0),
-  [558] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_list, 2, 0, 0),
-  [560] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_str, 3, 0, 0),
-  [562] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_block, 3, 0, 0),
-  [564] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_list, 3, 0, 0),
-  [566] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_iapp, 3, 0, 0),
-  [568] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_app, 3, 0, 0),
-  [570] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_prop, 3, 0, 0),
-  [572] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_list, 4, 0, 0),
-  [574] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_rec, 5, 0, 0),
-  [576] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_list, 5, 0, 0),
-  [578] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_app, 5, 0, 0),
-  [580] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_rec, 6, 0, 0),
-  [582] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_app, 6, 0, 0),
-  [584] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_rec, 7, 0, 0),
-  [586] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 7, 0, 0),
-  [588] = {.entry = {.count = 1, .reusable = false}}, SHIFT(163),
-  [590] = {.entry = {.count = 1, .reusable = false}}, SHIFT(40),
-  [592] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_when_repeat1, 5, 0, 0),
-  [594] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 8, 0, 0),
-  [596] = {.entry = {.count = 1, .reusable = false}}, SHIFT(41),
-  [598] = {.entry = {.count = 1, .reusable = false}}, SHIFT(165),
-  [600] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 9, 0, 0),
-  [602] = {.entry = {.count = 1, .reusable = false}}, SHIFT(42),
-  [604] = {.entry = {.count = 1, .reusable = false}}, SHIFT(68),
-  [606] = {.entry = {.count = 1, .reusable = false}}, SHIFT(69),
-  [608] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_lam, 3, 0, 0),
-  [610] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_lam, 4, 0, 0),
-  [612] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_lam, 5, 0, 0),
-  [614] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_lam, 6, 0, 0),
-  [616] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 10, 0, 0),
-  [618] = {.entry = {.count = 1, .reusable = true}}, SHIFT(81),
-  [620] = {.entry = {.count = 1, .reusable = true}}, SHIFT(151),
-  [622] = {.entry = {.count = 1, .reusable = false}}, SHIFT(18),
-  [624] = {.entry = {.count = 1, .reusable = true}}, SHIFT(76),
-  [626] = {.entry = {.count = 1, .reusable = true}}, SHIFT(269),
-  [628] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__inner_block, 2, 0, 0),
-  [630] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_cons_repeat1, 2, 0, 0),
-  [632] = {.entry = {.count = 1, .reusable = true}}, SHIFT(333),
-  [634] = {.entry = {.count = 1, .reusable = true}}, SHIFT(82),
-  [636] = {.entry = {.count = 1, .reusable = true}}, SHIFT(83),
-  [638] = {.entry = {.count = 1, .reusable = true}}, SHIFT(143),
-  [640] = {.entry = {.count = 1, .reusable = true}}, SHIFT(156),
-  [642] = {.entry = {.count = 1, .reusable = true}}, SHIFT(246),
-  [644] = {.entry = {.count = 1, .reusable = true}}, SHIFT(89),
-  [646] = {.entry = {.count = 1, .reusable = true}}, SHIFT(84),
-  [648] = {.entry = {.count = 1, .reusable = true}}, SHIFT(159),
-  [650] = {.entry = {.count = 1, .reusable = true}}, SHIFT(263),
-  [652] = {.entry = {.count = 1, .reusable = true}}, SHIFT(253),
-  [654] = {.entry = {.count = 1, .reusable = true}}, SHIFT(257),
-  [656] = {.entry = {.count = 1, .reusable = true}}, SHIFT(72),
-  [658] = {.entry = {.count = 1, .reusable = true}}, SHIFT(314),
-  [660] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__inner_block, 1, 0, 0),
-  [662] = {.entry = {.count = 1, .reusable = true}}, SHIFT(238),
-  [664] = {.entry = {.count = 1, .reusable = true}}, SHIFT(218),
-  [666] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_assign, 3, 0, 0),
-  [668] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_assign, 3, 0, 0),
-  [670] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_bind, 3, 0, 0),
-  [672] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_bind, 3, 0, 0),
-  [674] = {.entry = {.count = 1, .reusable = true}}, SHIFT(347),
-  [676] = {.entry = {.count = 1, .reusable = true}}, SHIFT(74),
-  [678] = {.entry = {.count = 1, .reusable = true}}, SHIFT(75),
-  [680] = {.entry = {.count = 1, .reusable = true}}, SHIFT(77),
-  [682] = {.entry = {.count = 1, .reusable = true}}, SHIFT(85),
-  [684] = {.entry = {.count = 1, .reusable = true}}, SHIFT(73),
-  [686] = {.entry = {.count = 1, .reusable = true}}, SHIFT(376),
-  [688] = {.entry = {.count = 1, .reusable = false}}, SHIFT(208),
-  [690] = {.entry = {.count = 1, .reusable = false}}, SHIFT(90),
-  [692] = {.entry = {.count = 1, .reusable = false}}, SHIFT(22),
-  [694] = {.entry = {.count = 1, .reusable = true}}, SHIFT(298),
-  [696] = {.entry = {.count = 1, .reusable = true}}, SHIFT(301),
-  [698] = {.entry = {.count = 1, .reusable = true}}, SHIFT(306),
-  [700] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_str_repeat1, 2, 0, 0), SHIFT_REPEAT(208),
-  [703] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_str_repeat1, 2, 0, 0),
-  [705] = {.entry = {.count = 2, .reusable = false}}, REDUCE(aux_sym_str_repeat1, 2, 0, 0), SHIFT_REPEAT(22),
-  [708] = {.entry = {.count = 1, .reusable = true}}, SHIFT(378),
-  [710] = {.entry = {.count = 1, .reusable = false}}, SHIFT(211),
-  [712] = {.entry = {.count = 1, .reusable = false}}, SHIFT(145),
-  [714] = {.entry = {.count = 1, .reusable = false}}, SHIFT(149),
-  [716] = {.entry = {.count = 1, .reusable = true}}, SHIFT(324),
-  [718] = {.entry = {.count = 1, .reusable = true}}, SHIFT(244),
-  [720] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 1, 0, 0),
-  [722] = {.entry = {.count = 1, .reusable = true}}, SHIFT(113),
-  [724] = {.entry = {.count = 1, .reusable = true}}, SHIFT(115),
-  [726] = {.entry = {.count = 1, .reusable = false}}, SHIFT(204),
-  [728] = {.entry = {.count = 1, .reusable = false}}, SHIFT(103),
-  [730] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 6, 0, 0),
-  [732] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_rec, 7, 0, 0),
-  [734] = {.entry = {.count = 1, .reusable = true}}, SHIFT(387),
-  [736] = {.entry = {.count = 1, .reusable = true}}, SHIFT(107),
-  [738] = {.entry = {.count = 1, .reusable = true}}, SHIFT(114),
-  [740] = {.entry = {.count = 1, .reusable = true}}, SHIFT(34),
-  [742] = {.entry = {.count = 1, .reusable = true}}, SHIFT(70),
-  [744] = {.entry = {.count = 1, .reusable = true}}, SHIFT(388),
-  [746] = {.entry = {.count = 1, .reusable = true}}, SHIFT(230),
-  [748] = {.entry = {.count = 1, .reusable = true}}, SHIFT(140),
-  [750] = {.entry = {.count = 1, .reusable = true}}, SHIFT(231),
-  [752] = {.entry = {.count = 1, .reusable = true}}, SHIFT(375),
-  [754] = {.entry = {.count = 1, .reusable = true}}, SHIFT(146),
-  [756] = {.entry = {.count = 1, .reusable = true}}, SHIFT(248),
-  [758] = {.entry = {.count = 1, .reusable = true}}, SHIFT(33),
-  [760] = {.entry = {.count = 1, .reusable = true}}, SHIFT(368),
-  [762] = {.entry = {.count = 1, .reusable = true}}, SHIFT(331),
-  [764] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_cons, 1, 0, 0),
-  [766] = {.entry = {.count = 1, .reusable = false}}, SHIFT(112),
-  [768] = {.entry = {.count = 1, .reusable = true}}, SHIFT(137),
-  [770] = {.entry = {.count = 1, .reusable = true}}, SHIFT(326),
-  [772] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_rec, 2, 0, 0),
-  [774] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_union, 2, 0, 0),
-  [776] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 3, 0, 0),
-  [778] = {.entry = {.count = 1, .reusable = true}}, SHIFT(381),
-  [780] = {.entry = {.count = 1, .reusable = true}}, SHIFT(305),
-  [782] = {.entry = {.count = 1, .reusable = true}}, SHIFT(141),
-  [784] = {.entry = {.count = 1, .reusable = true}}, SHIFT(309),
-  [786] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 4, 0, 0),
-  [788] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_union, 4, 0, 0),
-  [790] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_rec, 5, 0, 0),
-  [792] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 5, 0, 0),
-  [794] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_union, 5, 0, 0),
-  [796] = {.entry = {.count = 1, .reusable = true}}, SHIFT(327),
-  [798] = {.entry = {.count = 1, .reusable = true}}, SHIFT(43),
-  [800] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_rec, 6, 0, 0),
-  [802] = {.entry = {.count = 1, .reusable = true}}, SHIFT(342),
-  [804] = {.entry = {.count = 1, .reusable = true}}, SHIFT(55),
-  [806] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_union, 6, 0, 0),
-  [808] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_str_repeat1, 3, 0, 0),
-  [810] = {.entry = {.count = 1, .reusable = true}}, SHIFT(335),
-  [812] = {.entry = {.count = 1, .reusable = true}}, SHIFT(63),
-  [814] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_rec_repeat1, 4, 0, 0),
-  [816] = {.entry = {.count = 1, .reusable = true}}, SHIFT(394),
-  [818] = {.entry = {.count = 1, .reusable = true}}, SHIFT(251),
-  [820] = {.entry = {.count = 1, .reusable = true}}, SHIFT(35),
-  [822] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_union, 6, 0, 0),
-  [824] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_lam_repeat1, 2, 0, 0),
-  [826] = {.entry = {.count = 1, .reusable = true}}, SHIFT(36),
-  [828] = {.entry = {.count = 1, .reusable = true}}, SHIFT(255),
-  [830] = {.entry = {.count = 1, .reusable = true}}, SHIFT(37),
-  [832] = {.entry = {.count = 1, .reusable = true}}, SHIFT(371),
-  [834] = {.entry = {.count = 1, .reusable = true}}, SHIFT(189),
-  [836] = {.entry = {.count = 1, .reusable = true}}, SHIFT(334),
-  [838] = {.entry = {.count = 1, .reusable = true}}, SHIFT(267),
-  [840] = {.entry = {.count = 1, .reusable = true}}, SHIFT(341),
-  [842] = {.entry = {.count = 1, .reusable = true}}, SHIFT(26),
-  [844] = {.entry = {.count = 1, .reusable = true}}, SHIFT(161),
-  [846] = {.entry = {.count = 1, .reusable = true}}, SHIFT(250),
-  [848] = {.entry = {.count = 1, .reusable = true}}, SHIFT(270),
-  [850] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_union, 5, 0, 0),
-  [852] = {.entry = {.count = 1, .reusable = true}}, SHIFT(190),
-  [854] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_union_repeat1, 3, 0, 0),
-  [856] = {.entry = {.count = 1, .reusable = true}}, SHIFT(254),
-  [858] = {.entry = {.count = 1, .reusable = true}}, SHIFT(273),
-  [860] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_rec_repeat1, 4, 0, 0),
-  [862] = {.entry = {.count = 1, .reusable = true}}, SHIFT(256),
-  [864] = {.entry = {.count = 1, .reusable = true}}, SHIFT(274),
-  [866] = {.entry = {.count = 1, .reusable = true}}, SHIFT(258),
-  [868] = {.entry = {.count = 1, .reusable = true}}, SHIFT(272),
-  [870] = {.entry = {.count = 1, .reusable = true}}, SHIFT(249),
-  [872] = {.entry = {.count = 1, .reusable = true}}, SHIFT(322),
-  [874] = {.entry = {.count = 1, .reusable = true}}, SHIFT(193),
-  [876] = {.entry = {.count = 1, .reusable = true}}, SHIFT(235),
-  [878] = {.entry = {.count = 1, .reusable = true}}, SHIFT(281),
-  [880] = {.entry = {.count = 1, .reusable = true}}, SHIFT(236),
-  [882] = {.entry = {.count = 1, .reusable = true}}, SHIFT(237),
-  [884] = {.entry = {.count = 1, .reusable = true}}, SHIFT(283),
-  [886] = {.entry = {.count = 1, .reusable = true}}, SHIFT(194),
-  [888] = {.entry = {.count = 1, .reusable = true}}, SHIFT(239),
-  [890] = {.entry = {.count = 1, .reusable = true}}, SHIFT(286),
-  [892] = {.entry = {.count = 1, .reusable = true}}, SHIFT(241),
-  [894] = {.entry = {.count = 1, .reusable = true}}, SHIFT(287),
-  [896] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_rec, 7, 0, 0),
-  [898] = {.entry = {.count = 1, .reusable = true}}, SHIFT(243),
-  [900] = {.entry = {.count = 1, .reusable = true}}, SHIFT(219),
-  [902] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_type_rec_repeat1, 2, 0, 0), SHIFT_REPEAT(358),
-  [905] = {.entry = {.count = 1, .reusable = true}}, SHIFT(252),
-  [907] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_cons, 3, 0, 0),
-  [909] = {.entry = {.count = 1, .reusable = true}}, SHIFT(197),
-  [911] = {.entry = {.count = 1, .reusable = true}}, SHIFT(317),
-  [913] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_rec_repeat1, 2, 0, 0), SHIFT_REPEAT(380),
-  [916] = {.entry = {.count = 1, .reusable = true}}, SHIFT(319),
-  [918] = {.entry = {.count = 1, .reusable = true}}, SHIFT(320),
-  [920] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_type_union_repeat1, 2, 0, 0), SHIFT_REPEAT(132),
-  [923] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_when_repeat1, 2, 0, 0), SHIFT_REPEAT(370),
-  [926] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_annot, 3, 0, 0),
-  [928] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_annot, 3, 0, 0),
-  [930] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_rec, 6, 0, 0),
-  [932] = {.entry = {.count = 1, .reusable = true}}, SHIFT(353),
-  [934] = {.entry = {.count = 1, .reusable = true}}, SHIFT(138),
-  [936] = {.entry = {.count = 1, .reusable = true}}, SHIFT(361),
-  [938] = {.entry = {.count = 1, .reusable = true}}, SHIFT(366),
-  [940] = {.entry = {.count = 1, .reusable = true}}, SHIFT(133),
-  [942] = {.entry = {.count = 1, .reusable = true}}, SHIFT(397),
-  [944] = {.entry = {.count = 1, .reusable = true}}, SHIFT(377),
-  [946] = {.entry = {.count = 1, .reusable = true}}, SHIFT(383),
-  [948] = {.entry = {.count = 1, .reusable = true}}, SHIFT(385),
-  [950] = {.entry = {.count = 1, .reusable = true}}, SHIFT(386),
-  [952] = {.entry = {.count = 1, .reusable = true}}, SHIFT(391),
-  [954] = {.entry = {.count = 1, .reusable = true}}, SHIFT(285),
-  [956] = {.entry = {.count = 1, .reusable = false}}, SHIFT(110),
-  [958] = {.entry = {.count = 1, .reusable = true}}, SHIFT(110),
-  [960] = {.entry = {.count = 1, .reusable = true}}, SHIFT(24),
-  [962] = {.entry = {.count = 1, .reusable = true}}, SHIFT(96),
-  [964] = {.entry = {.count = 1, .reusable = true}}, SHIFT(330),
-  [966] = {.entry = {.count = 1, .reusable = true}}, SHIFT(332),
-  [968] = {.entry = {.count = 1, .reusable = true}}, SHIFT(367),
-  [970] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_cons, 4, 0, 0),
-  [972] = {.entry = {.count = 1, .reusable = true}}, SHIFT(182),
-  [974] = {.entry = {.count = 1, .reusable = true}}, SHIFT(265),
-  [976] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_union, 4, 0, 0),
-  [978] = {.entry = {.count = 1, .reusable = true}}, SHIFT(275),
-  [980] = {.entry = {.count = 1, .reusable = true}}, SHIFT(262),
-  [982] = {.entry = {.count = 2, .reusable = true}}, REDUCE(aux_sym_lam_repeat1, 2, 0, 0), SHIFT_REPEAT(390),
-  [985] = {.entry = {.count = 1, .reusable = true}}, SHIFT(348),
-  [987] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_import, 6, 0, 0),
-  [989] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import, 6, 0, 0),
-  [991] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_union, 2, 0, 0),
-  [993] = {.entry = {.count = 1, .reusable = true}}, SHIFT(313),
-  [995] = {.entry = {.count = 1, .reusable = true}}, SHIFT(21),
-  [997] = {.entry = {.count = 1, .reusable = true}}, SHIFT(131),
-  [999] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_rec, 5, 0, 0),
-  [1001] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_rec, 2, 0, 0),
-  [1003] = {.entry = {.count = 1, .reusable = true}}, SHIFT(297),
-  [1005] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_cons, 6, 0, 0),
-  [1007] = {.entry = {.count = 1, .reusable = true}}, SHIFT(336),
-  [1009] = {.entry = {.count = 1, .reusable = true}}, SHIFT(65),
-  [1011] = {.entry = {.count = 1, .reusable = true}}, SHIFT(66),
-  [1013] = {.entry = {.count = 1, .reusable = true}}, SHIFT(338),
-  [1015] = {.entry = {.count = 1, .reusable = true}}, SHIFT(67),
-  [1017] = {.entry = {.count = 1, .reusable = true}}, SHIFT(337),
-  [1019] = {.entry = {.count = 1, .reusable = true}}, SHIFT(311),
-  [1021] = {.entry = {.count = 1, .reusable = true}}, SHIFT(343),
-  [1023] = {.entry = {.count = 1, .reusable = true}}, SHIFT(57),
-  [1025] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
-  [1027] = {.entry = {.count = 1, .reusable = true}}, SHIFT(345),
-  [1029] = {.entry = {.count = 1, .reusable = true}}, SHIFT(59),
-  [1031] = {.entry = {.count = 1, .reusable = true}}, SHIFT(344),
-  [1033] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_type_cons, 5, 0, 0),
-  [1035] = {.entry = {.count = 1, .reusable = true}}, SHIFT(365),
-  [1037] = {.entry = {.count = 1, .reusable = true}}, SHIFT(325),
-  [1039] = {.entry = {.count = 1, .reusable = true}}, SHIFT(374),
-  [1041] = {.entry = {.count = 1, .reusable = true}}, SHIFT(154),
-  [1043] = {.entry = {.count = 1, .reusable = true}}, SHIFT(382),
-  [1045] = {.entry = {.count = 1, .reusable = true}}, SHIFT(46),
-  [1047] = {.entry = {.count = 1, .reusable = true}}, SHIFT(352),
-  [1049] = {.entry = {.count = 1, .reusable = true}}, SHIFT(372),
-  [1051] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 1, 0, 0),
-  [1053] = {.entry = {.count = 1, .reusable = true}}, SHIFT(134),
-  [1055] = {.entry = {.count = 1, .reusable = true}}, SHIFT(30),
-  [1057] = {.entry = {.count = 1, .reusable = true}}, SHIFT(44),
-  [1059] = {.entry = {.count = 1, .reusable = true}}, SHIFT(373),
-  [1061] = {.entry = {.count = 1, .reusable = true}}, SHIFT(91),
-  [1063] = {.entry = {.count = 1, .reusable = true}}, SHIFT(45),
-  [1065] = {.entry = {.count = 1, .reusable = true}}, SHIFT(27),
-  [1067] = {.entry = {.count = 1, .reusable = true}}, SHIFT(354),
-  [1069] = {.entry = {.count = 1, .reusable = true}}, SHIFT(369),
-  [1071] = {.entry = {.count = 1, .reusable = true}}, SHIFT(135),
-  [1073] = {.entry = {.count = 1, .reusable = true}}, SHIFT(28),
-  [1075] = {.entry = {.count = 1, .reusable = true}}, SHIFT(359),
-  [1077] = {.entry = {.count = 1, .reusable = true}}, SHIFT(127),
-  [1079] = {.entry = {.count = 1, .reusable = false}}, SHIFT(349),
-  [1081] = {.entry = {.count = 1, .reusable = true}}, SHIFT(39),
-  [1083] = {.entry = {.count = 1, .reusable = true}}, SHIFT(356),
-  [1085] = {.entry = {.count = 1, .reusable = true}}, SHIFT(49),
-  [1087] = {.entry = {.count = 1, .reusable = true}}, SHIFT(51),
-  [1089] = {.entry = {.count = 1, .reusable = true}}, SHIFT(25),
-  [1091] = {.entry = {.count = 1, .reusable = true}}, SHIFT(136),
-  [1093] = {.entry = {.count = 1, .reusable = true}}, SHIFT(38),
-  [1095] = {.entry = {.count = 1, .reusable = true}}, SHIFT(360),
-  [1097] = {.entry = {.count = 1, .reusable = true}}, SHIFT(47),
-  [1099] = {.entry = {.count = 1, .reusable = true}}, SHIFT(139),
-  [1101] = {.entry = {.count = 1, .reusable = true}}, SHIFT(364),
-  [1103] = {.entry = {.count = 1, .reusable = true}}, SHIFT(20),
-  [1105] = {.entry = {.count = 1, .reusable = true}}, SHIFT(142),
-  [1107] = {.entry = {.count = 1, .reusable = true}}, SHIFT(217),
-  [1109] = {.entry = {.count = 1, .reusable = true}}, SHIFT(363),
-  [1111] = {.entry = {.count = 1, .reusable = true}}, SHIFT(128),
-  [1113] = {.entry = {.count = 1, .reusable = true}},  ACCEPT_INPUT(),
-  [1115] = {.entry = {.count = 1, .reusable = true}}, SHIFT(108),
-  [1117] = {.entry = {.count = 1, .reusable = true}}, SHIFT(23),
-  [1119] = {.entry = {.count = 1, .reusable = true}}, SHIFT(379),
-  [1121] = {.entry = {.count = 1, .reusable = true}}, SHIFT(150),
-  [1123] = {.entry = {.count = 1, .reusable = true}}, SHIFT(384),
+  [5] = {.entry = {.count = 1, .reusable = false}}, SHIFT(2),
+  [7] = {.entry = {.count = 1, .reusable = true}}, SHIFT(3),
+  [9] = {.entry = {.count = 1, .reusable = true}}, SHIFT(4),
+  [11] = {.entry = {.count = 1, .reusable = true}}, SHIFT(5),
+  [13] = {.entry = {.count = 1, .reusable = true}}, SHIFT(6),
+  [15] = {.entry = {.count = 1, .reusable = true}}, SHIFT(7),
+  [17] = {.entry = {.count = 1, .reusable = true}}, SHIFT(8),
+  [19] = {.entry = {.count = 1, .reusable = true}}, SHIFT(9),
+  [21] = {.entry = {.count = 1, .reusable = false}}, SHIFT(10),
+  [23] = {.entry = {.count = 1, .reusable = true}}, SHIFT(11),
+  [25] = {.entry = {.count = 1, .reusable = false}}, SHIFT(12),
+  [27] = {.entry = {.count = 1, .reusable = false}}, SHIFT(13),
+  [29] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__expr, 1, 0, 0),
+  [31] = {.entry = {.count = 1, .reusable = true}}, SHIFT(35),
+  [33] = {.entry = {.count = 1, .reusable = true}}, SHIFT(36),
+  [35] = {.entry = {.count = 1, .reusable = true}}, SHIFT(37),
+  [37] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym__expr, 1, 0, 0),
+  [39] = {.entry = {.count = 1, .reusable = true}}, SHIFT(38),
+  [41] = {.entry = {.count = 1, .reusable = true}}, SHIFT(39),
+  [43] = {.entry = {.count = 1, .reusable = true}}, SHIFT(40),
+  [45] = {.entry = {.count = 1, .reusable = false}}, SHIFT(42),
+  [47] = {.entry = {.count = 1, .reusable = true}}, SHIFT(43),
+  [49] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_cons, 1, 0, 0),
+  [51] = {.entry = {.count = 1, .reusable = false}}, SHIFT(45),
+  [53] = {.entry = {.count = 1, .reusable = false}}, SHIFT(50),
+  [55] = {.entry = {.count = 1, .reusable = true}}, SHIFT(51),
+  [57] = {.entry = {.count = 1, .reusable = true}}, SHIFT(53),
+  [59] = {.entry = {.count = 1, .reusable = true}}, SHIFT(54),
+  [61] = {.entry = {.count = 1, .reusable = true}}, SHIFT(55),
+  [63] = {.entry = {.count = 1, .reusable = false}}, SHIFT(56),
+  [65] = {.entry = {.count = 1, .reusable = true}}, SHIFT(58),
+  [67] = {.entry = {.count = 1, .reusable = false}}, SHIFT(61),
+  [69] = {.entry = {.count = 1, .reusable = false}}, SHIFT(62),
+  [71] = {.entry = {.count = 1, .reusable = true}}, ACCEPT_INPUT(),
+  [73] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__inner_block, 1, 0, 0),
+  [75] = {.entry = {.count = 1, .reusable = true}}, SHIFT(63),
+  [77] = {.entry = {.count = 1, .reusable = true}}, SHIFT(64),
+  [79] = {.entry = {.count = 1, .reusable = true}}, SHIFT(65),
+  [81] = {.entry = {.count = 1, .reusable = true}}, SHIFT(66),
+  [83] = {.entry = {.count = 1, .reusable = true}}, SHIFT(67),
+  [85] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__decl, 1, 0, 0),
+  [87] = {.entry = {.count = 1, .reusable = true}}, SHIFT(68),
+  [89] = {.entry = {.count = 1, .reusable = true}}, SHIFT(69),
+  [91] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_source_file, 1, 0, 0),
+  [93] = {.entry = {.count = 1, .reusable = false}}, SHIFT(72),
+  [95] = {.entry = {.count = 1, .reusable = true}}, SHIFT(73),
+  [97] = {.entry = {.count = 1, .reusable = true}}, SHIFT(74),
+  [99] = {.entry = {.count = 1, .reusable = true}}, SHIFT(75),
+  [101] = {.entry = {.count = 1, .reusable = true}}, SHIFT(76),
+  [103] = {.entry = {.count = 1, .reusable = false}}, SHIFT(82),
+  [105] = {.entry = {.count = 1, .reusable = true}}, SHIFT(83),
+  [107] = {.entry = {.count = 1, .reusable = true}}, SHIFT(84),
+  [109] = {.entry = {.count = 1, .reusable = true}}, SHIFT(85),
+  [111] = {.entry = {.count = 1, .reusable = true}}, SHIFT(86),
+  [113] = {.entry = {.count = 1, .reusable = true}}, SHIFT(87),
+  [115] = {.entry = {.count = 1, .reusable = true}}, SHIFT(88),
+  [117] = {.entry = {.count = 1, .reusable = true}}, SHIFT(89),
+  [119] = {.entry = {.count = 1, .reusable = false}}, SHIFT(90),
+  [121] = {.entry = {.count = 1, .reusable = true}}, SHIFT(91),
+  [123] = {.entry = {.count = 1, .reusable = false}}, SHIFT(92),
+  [125] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_str_repeat1, 1, 0, 0),
+  [127] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_str, 2, 0, 0),
+  [129] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_str, 2, 0, 0),
+  [131] = {.entry = {.count = 1, .reusable = true}}, SHIFT(108),
+  [133] = {.entry = {.count = 1, .reusable = true}}, SHIFT(109),
+  [135] = {.entry = {.count = 1, .reusable = true}}, SHIFT(110),
+  [137] = {.entry = {.count = 1, .reusable = true}}, SHIFT(111),
+  [139] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rec, 2, 0, 0),
+  [141] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_rec, 2, 0, 0),
+  [143] = {.entry = {.count = 1, .reusable = false}}, SHIFT(112),
+  [145] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_cons, 2, 0, 0),
+  [147] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_cons, 2, 0, 0),
+  [149] = {.entry = {.count = 1, .reusable = true}}, SHIFT(113),
+  [151] = {.entry = {.count = 1, .reusable = true}}, SHIFT(115),
+  [153] = {.entry = {.count = 1, .reusable = true}}, SHIFT(116),
+  [155] = {.entry = {.count = 1, .reusable = true}}, SHIFT(117),
+  [157] = {.entry = {.count = 1, .reusable = false}}, SHIFT(119),
+  [159] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_cons, 1, 0, 0),
+  [161] = {.entry = {.count = 1, .reusable = false}}, SHIFT(121),
+  [163] = {.entry = {.count = 1, .reusable = true}}, SHIFT(122),
+  [165] = {.entry = {.count = 1, .reusable = true}}, SHIFT(125),
+  [167] = {.entry = {.count = 1, .reusable = false}}, SHIFT(126),
+  [169] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 2, 0, 0),
+  [171] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_list, 2, 0, 0),
+  [173] = {.entry = {.count = 1, .reusable = true}}, SHIFT(127),
+  [175] = {.entry = {.count = 1, .reusable = true}}, SHIFT(128),
+  [177] = {.entry = {.count = 1, .reusable = false}}, SHIFT(130),
+  [179] = {.entry = {.count = 1, .reusable = true}}, SHIFT(131),
+  [181] = {.entry = {.count = 1, .reusable = true}}, SHIFT(132),
+  [183] = {.entry = {.count = 1, .reusable = true}}, SHIFT(136),
+  [185] = {.entry = {.count = 1, .reusable = false}}, SHIFT(139),
+  [187] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym__inner_block_repeat1, 2, 0, 0),
+  [189] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym__inner_block_repeat1, 2, 0, 0),
+  [191] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__inner_block, 2, 0, 0),
+  [193] = {.entry = {.count = 1, .reusable = true}}, SHIFT(142),
+  [195] = {.entry = {.count = 1, .reusable = true}}, SHIFT(143),
+  [197] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym__type, 1, 0, 0),
+  [199] = {.entry = {.count = 1, .reusable = false}}, SHIFT(144),
+  [201] = {.entry = {.count = 1, .reusable = true}}, SHIFT(145),
+  [203] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 1, 0, 0),
+  [205] = {.entry = {.count = 1, .reusable = true}}, SHIFT(147),
+  [207] = {.entry = {.count = 1, .reusable = false}}, SHIFT(148),
+  [209] = {.entry = {.count = 1, .reusable = true}}, SHIFT(149),
+  [211] = {.entry = {.count = 1, .reusable = true}}, SHIFT(150),
+  [213] = {.entry = {.count = 1, .reusable = true}}, SHIFT(151),
+  [215] = {.entry = {.count = 1, .reusable = true}}, SHIFT(152),
+  [217] = {.entry = {.count = 1, .reusable = true}}, SHIFT(158),
+  [219] = {.entry = {.count = 1, .reusable = true}}, SHIFT(159),
+  [221] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_annot, 3, 0, 0),
+  [223] = {.entry = {.count = 1, .reusable = true}}, SHIFT(161),
+  [225] = {.entry = {.count = 1, .reusable = false}}, SHIFT(163),
+  [227] = {.entry = {.count = 1, .reusable = true}}, SHIFT(164),
+  [229] = {.entry = {.count = 1, .reusable = false}}, SHIFT(169),
+  [231] = {.entry = {.count = 1, .reusable = true}}, SHIFT(170),
+  [233] = {.entry = {.count = 1, .reusable = true}}, SHIFT(173),
+  [235] = {.entry = {.count = 1, .reusable = false}}, SHIFT(176),
+  [237] = {.entry = {.count = 1, .reusable = true}}, SHIFT(177),
+  [239] = {.entry = {.count = 1, .reusable = true}}, SHIFT(178),
+  [241] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_assign, 3, 0, 0),
+  [243] = {.entry = {.count = 1, .reusable = true}}, SHIFT(179),
+  [245] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_bind, 3, 0, 0),
+  [247] = {.entry = {.count = 1, .reusable = true}}, SHIFT(180),
+  [249] = {.entry = {.count = 1, .reusable = true}}, SHIFT(181),
+  [251] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_str_repeat1, 2, 0, 0),
+  [253] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_str, 3, 0, 0),
+  [255] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_str, 3, 0, 0),
+  [257] = {.entry = {.count = 1, .reusable = true}}, SHIFT(184),
+  [259] = {.entry = {.count = 1, .reusable = true}}, SHIFT(186),
+  [261] = {.entry = {.count = 1, .reusable = true}}, SHIFT(187),
+  [263] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_block, 3, 0, 0),
+  [265] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_block, 3, 0, 0),
+  [267] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_lam_repeat1, 2, 0, 0),
+  [269] = {.entry = {.count = 1, .reusable = true}}, SHIFT(188),
+  [271] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_lam, 3, 0, 0),
+  [273] = {.entry = {.count = 1, .reusable = true}}, SHIFT(190),
+  [275] = {.entry = {.count = 1, .reusable = true}}, SHIFT(191),
+  [277] = {.entry = {.count = 1, .reusable = true}}, SHIFT(193),
+  [279] = {.entry = {.count = 1, .reusable = true}}, SHIFT(194),
+  [281] = {.entry = {.count = 1, .reusable = false}}, SHIFT(196),
+  [283] = {.entry = {.count = 1, .reusable = false}}, SHIFT(197),
+  [285] = {.entry = {.count = 1, .reusable = true}}, SHIFT(198),
+  [287] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0),
+  [289] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 2, 0, 0),
+  [291] = {.entry = {.count = 1, .reusable = true}}, SHIFT(200),
+  [293] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 3, 0, 0),
+  [295] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_list, 3, 0, 0),
+  [297] = {.entry = {.count = 1, .reusable = true}}, SHIFT(201),
+  [299] = {.entry = {.count = 1, .reusable = true}}, SHIFT(202),
+  [301] = {.entry = {.count = 1, .reusable = true}}, SHIFT(203),
+  [303] = {.entry = {.count = 1, .reusable = false}}, SHIFT(204),
+  [305] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_with, 3, 0, 0),
+  [307] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_with, 3, 0, 0),
+  [309] = {.entry = {.count = 1, .reusable = true}}, SHIFT(205),
+  [311] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_iapp, 3, 0, 0),
+  [313] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_iapp, 3, 0, 0),
+  [315] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_app, 3, 0, 0),
+  [317] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_app, 3, 0, 0),
+  [319] = {.entry = {.count = 1, .reusable = true}}, SHIFT(207),
+  [321] = {.entry = {.count = 1, .reusable = true}}, SHIFT(208),
+  [323] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_prop, 3, 0, 0),
+  [325] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_prop, 3, 0, 0),
+  [327] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym__inner_block_repeat1, 3, 0, 0),
+  [329] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym__inner_block_repeat1, 3, 0, 0),
+  [331] = {.entry = {.count = 1, .reusable = true}}, SHIFT(210),
+  [333] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_rec, 2, 0, 0),
+  [335] = {.entry = {.count = 1, .reusable = false}}, SHIFT(211),
+  [337] = {.entry = {.count = 1, .reusable = false}}, SHIFT(212),
+  [339] = {.entry = {.count = 1, .reusable = true}}, SHIFT(213),
+  [341] = {.entry = {.count = 1, .reusable = true}}, SHIFT(214),
+  [343] = {.entry = {.count = 1, .reusable = true}}, SHIFT(215),
+  [345] = {.entry = {.count = 1, .reusable = true}}, SHIFT(216),
+  [347] = {.entry = {.count = 1, .reusable = true}}, SHIFT(217),
+  [349] = {.entry = {.count = 1, .reusable = false}}, SHIFT(224),
+  [351] = {.entry = {.count = 1, .reusable = true}}, SHIFT(225),
+  [353] = {.entry = {.count = 1, .reusable = true}}, SHIFT(227),
+  [355] = {.entry = {.count = 1, .reusable = true}}, SHIFT(229),
+  [357] = {.entry = {.count = 1, .reusable = true}}, SHIFT(230),
+  [359] = {.entry = {.count = 1, .reusable = true}}, SHIFT(232),
+  [361] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_union, 2, 0, 0),
+  [363] = {.entry = {.count = 1, .reusable = true}}, SHIFT(235),
+  [365] = {.entry = {.count = 1, .reusable = true}}, SHIFT(236),
+  [367] = {.entry = {.count = 1, .reusable = true}}, SHIFT(237),
+  [369] = {.entry = {.count = 1, .reusable = false}}, SHIFT(238),
+  [371] = {.entry = {.count = 1, .reusable = true}}, SHIFT(240),
+  [373] = {.entry = {.count = 1, .reusable = true}}, SHIFT(241),
+  [375] = {.entry = {.count = 1, .reusable = true}}, SHIFT(242),
+  [377] = {.entry = {.count = 1, .reusable = false}}, SHIFT(244),
+  [379] = {.entry = {.count = 1, .reusable = false}}, SHIFT(245),
+  [381] = {.entry = {.count = 1, .reusable = true}}, SHIFT(246),
+  [383] = {.entry = {.count = 1, .reusable = true}}, SHIFT(247),
+  [385] = {.entry = {.count = 1, .reusable = true}}, SHIFT(249),
+  [387] = {.entry = {.count = 1, .reusable = true}}, SHIFT(253),
+  [389] = {.entry = {.count = 1, .reusable = false}}, SHIFT(256),
+  [391] = {.entry = {.count = 1, .reusable = true}}, SHIFT(257),
+  [393] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_str_repeat1, 3, 0, 0),
+  [395] = {.entry = {.count = 1, .reusable = true}}, SHIFT(258),
+  [397] = {.entry = {.count = 1, .reusable = true}}, SHIFT(259),
+  [399] = {.entry = {.count = 1, .reusable = true}}, SHIFT(260),
+  [401] = {.entry = {.count = 1, .reusable = true}}, SHIFT(261),
+  [403] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_tuple_repeat1, 2, 0, 0),
+  [405] = {.entry = {.count = 1, .reusable = true}}, SHIFT(263),
+  [407] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_tuple, 4, 0, 0),
+  [409] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_lam, 4, 0, 0),
+  [411] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_lam_repeat1, 3, 0, 0),
+  [413] = {.entry = {.count = 1, .reusable = true}}, SHIFT(266),
+  [415] = {.entry = {.count = 1, .reusable = true}}, SHIFT(268),
+  [417] = {.entry = {.count = 1, .reusable = true}}, SHIFT(269),
+  [419] = {.entry = {.count = 1, .reusable = true}}, SHIFT(270),
+  [421] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_lam, 3, 0, 0),
+  [423] = {.entry = {.count = 1, .reusable = true}}, SHIFT(272),
+  [425] = {.entry = {.count = 1, .reusable = true}}, SHIFT(273),
+  [427] = {.entry = {.count = 1, .reusable = true}}, SHIFT(274),
+  [429] = {.entry = {.count = 1, .reusable = false}}, SHIFT(276),
+  [431] = {.entry = {.count = 1, .reusable = true}}, SHIFT(277),
+  [433] = {.entry = {.count = 1, .reusable = true}}, SHIFT(279),
+  [435] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 4, 0, 0),
+  [437] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_list, 4, 0, 0),
+  [439] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_app_repeat1, 3, 0, 0),
+  [441] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_app_repeat1, 3, 0, 0),
+  [443] = {.entry = {.count = 1, .reusable = true}}, SHIFT(280),
+  [445] = {.entry = {.count = 1, .reusable = true}}, SHIFT(281),
+  [447] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_with_repeat1, 2, 0, 0),
+  [449] = {.entry = {.count = 1, .reusable = false}}, SHIFT(282),
+  [451] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_with, 4, 0, 0),
+  [453] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_with, 4, 0, 0),
+  [455] = {.entry = {.count = 1, .reusable = true}}, SHIFT(283),
+  [457] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_app, 4, 0, 0),
+  [459] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_app, 4, 0, 0),
+  [461] = {.entry = {.count = 1, .reusable = true}}, SHIFT(284),
+  [463] = {.entry = {.count = 1, .reusable = true}}, SHIFT(285),
+  [465] = {.entry = {.count = 1, .reusable = true}}, SHIFT(287),
+  [467] = {.entry = {.count = 1, .reusable = false}}, SHIFT(288),
+  [469] = {.entry = {.count = 1, .reusable = true}}, SHIFT(289),
+  [471] = {.entry = {.count = 1, .reusable = true}}, SHIFT(291),
+  [473] = {.entry = {.count = 1, .reusable = true}}, SHIFT(293),
+  [475] = {.entry = {.count = 1, .reusable = true}}, SHIFT(294),
+  [477] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 3, 0, 0),
+  [479] = {.entry = {.count = 1, .reusable = true}}, SHIFT(296),
+  [481] = {.entry = {.count = 1, .reusable = true}}, SHIFT(297),
+  [483] = {.entry = {.count = 1, .reusable = true}}, SHIFT(299),
+  [485] = {.entry = {.count = 1, .reusable = false}}, SHIFT(300),
+  [487] = {.entry = {.count = 1, .reusable = true}}, SHIFT(301),
+  [489] = {.entry = {.count = 1, .reusable = true}}, SHIFT(306),
+  [491] = {.entry = {.count = 1, .reusable = true}}, SHIFT(308),
+  [493] = {.entry = {.count = 1, .reusable = true}}, SHIFT(309),
+  [495] = {.entry = {.count = 1, .reusable = true}}, SHIFT(310),
+  [497] = {.entry = {.count = 1, .reusable = true}}, SHIFT(311),
+  [499] = {.entry = {.count = 1, .reusable = true}}, SHIFT(314),
+  [501] = {.entry = {.count = 1, .reusable = true}}, SHIFT(315),
+  [503] = {.entry = {.count = 1, .reusable = true}}, SHIFT(316),
+  [505] = {.entry = {.count = 1, .reusable = true}}, SHIFT(317),
+  [507] = {.entry = {.count = 1, .reusable = true}}, SHIFT(319),
+  [509] = {.entry = {.count = 1, .reusable = true}}, SHIFT(320),
+  [511] = {.entry = {.count = 1, .reusable = true}}, SHIFT(321),
+  [513] = {.entry = {.count = 1, .reusable = true}}, SHIFT(323),
+  [515] = {.entry = {.count = 1, .reusable = true}}, SHIFT(324),
+  [517] = {.entry = {.count = 1, .reusable = true}}, SHIFT(325),
+  [519] = {.entry = {.count = 1, .reusable = true}}, SHIFT(327),
+  [521] = {.entry = {.count = 1, .reusable = true}}, SHIFT(328),
+  [523] = {.entry = {.count = 1, .reusable = true}}, SHIFT(330),
+  [525] = {.entry = {.count = 1, .reusable = true}}, SHIFT(331),
+  [527] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_str_repeat1, 4, 0, 0),
+  [529] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rec, 5, 0, 0),
+  [531] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_rec, 5, 0, 0),
+  [533] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_rec_repeat1, 4, 0, 0),
+  [535] = {.entry = {.count = 1, .reusable = true}}, SHIFT(332),
+  [537] = {.entry = {.count = 1, .reusable = true}}, SHIFT(333),
+  [539] = {.entry = {.count = 1, .reusable = true}}, SHIFT(334),
+  [541] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_tuple, 5, 0, 0),
+  [543] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_tuple_repeat1, 3, 0, 0),
+  [545] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_lam, 5, 0, 0),
+  [547] = {.entry = {.count = 1, .reusable = true}}, SHIFT(336),
+  [549] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_tuple, 4, 0, 0),
+  [551] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_lam, 4, 0, 0),
+  [553] = {.entry = {.count = 1, .reusable = true}}, SHIFT(338),
+  [555] = {.entry = {.count = 1, .reusable = false}}, SHIFT(340),
+  [557] = {.entry = {.count = 1, .reusable = true}}, SHIFT(342),
+  [559] = {.entry = {.count = 1, .reusable = true}}, SHIFT(343),
+  [561] = {.entry = {.count = 1, .reusable = true}}, SHIFT(345),
+  [563] = {.entry = {.count = 1, .reusable = false}}, SHIFT(346),
+  [565] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_list, 5, 0, 0),
+  [567] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_list, 5, 0, 0),
+  [569] = {.entry = {.count = 1, .reusable = true}}, SHIFT(348),
+  [571] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_with_repeat1, 3, 0, 0),
+  [573] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_app, 5, 0, 0),
+  [575] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_app, 5, 0, 0),
+  [577] = {.entry = {.count = 1, .reusable = true}}, SHIFT(349),
+  [579] = {.entry = {.count = 1, .reusable = true}}, SHIFT(350),
+  [581] = {.entry = {.count = 1, .reusable = true}}, SHIFT(351),
+  [583] = {.entry = {.count = 1, .reusable = true}}, SHIFT(353),
+  [585] = {.entry = {.count = 1, .reusable = false}}, SHIFT(354),
+  [587] = {.entry = {.count = 1, .reusable = true}}, SHIFT(355),
+  [589] = {.entry = {.count = 1, .reusable = true}}, SHIFT(360),
+  [591] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_type_cons_repeat1, 2, 0, 0),
+  [593] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_cons_repeat1, 2, 0, 0),
+  [595] = {.entry = {.count = 1, .reusable = true}}, SHIFT(361),
+  [597] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 4, 0, 0),
+  [599] = {.entry = {.count = 1, .reusable = true}}, SHIFT(362),
+  [601] = {.entry = {.count = 1, .reusable = true}}, SHIFT(363),
+  [603] = {.entry = {.count = 1, .reusable = true}}, SHIFT(365),
+  [605] = {.entry = {.count = 1, .reusable = true}}, SHIFT(366),
+  [607] = {.entry = {.count = 1, .reusable = true}}, SHIFT(367),
+  [609] = {.entry = {.count = 1, .reusable = true}}, SHIFT(369),
+  [611] = {.entry = {.count = 1, .reusable = true}}, SHIFT(370),
+  [613] = {.entry = {.count = 1, .reusable = true}}, SHIFT(371),
+  [615] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_tuple_repeat1, 2, 0, 0),
+  [617] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_tuple, 4, 0, 0),
+  [619] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_union_repeat1, 3, 0, 0),
+  [621] = {.entry = {.count = 1, .reusable = true}}, SHIFT(374),
+  [623] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_union, 4, 0, 0),
+  [625] = {.entry = {.count = 1, .reusable = true}}, SHIFT(375),
+  [627] = {.entry = {.count = 1, .reusable = true}}, SHIFT(376),
+  [629] = {.entry = {.count = 1, .reusable = true}}, SHIFT(377),
+  [631] = {.entry = {.count = 1, .reusable = true}}, SHIFT(378),
+  [633] = {.entry = {.count = 1, .reusable = true}}, SHIFT(380),
+  [635] = {.entry = {.count = 1, .reusable = true}}, SHIFT(382),
+  [637] = {.entry = {.count = 1, .reusable = false}}, SHIFT(384),
+  [639] = {.entry = {.count = 1, .reusable = true}}, SHIFT(386),
+  [641] = {.entry = {.count = 1, .reusable = true}}, SHIFT(387),
+  [643] = {.entry = {.count = 1, .reusable = true}}, SHIFT(388),
+  [645] = {.entry = {.count = 1, .reusable = true}}, SHIFT(389),
+  [647] = {.entry = {.count = 1, .reusable = true}}, SHIFT(390),
+  [649] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_str_repeat1, 5, 0, 0),
+  [651] = {.entry = {.count = 1, .reusable = true}}, SHIFT(391),
+  [653] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rec, 6, 0, 0),
+  [655] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_rec, 6, 0, 0),
+  [657] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_rec_repeat1, 5, 0, 0),
+  [659] = {.entry = {.count = 1, .reusable = true}}, SHIFT(392),
+  [661] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_lam, 6, 0, 0),
+  [663] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_tuple, 5, 0, 0),
+  [665] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_lam, 5, 0, 0),
+  [667] = {.entry = {.count = 1, .reusable = true}}, SHIFT(394),
+  [669] = {.entry = {.count = 1, .reusable = true}}, SHIFT(395),
+  [671] = {.entry = {.count = 1, .reusable = false}}, SHIFT(396),
+  [673] = {.entry = {.count = 1, .reusable = true}}, SHIFT(398),
+  [675] = {.entry = {.count = 1, .reusable = true}}, SHIFT(399),
+  [677] = {.entry = {.count = 1, .reusable = false}}, SHIFT(400),
+  [679] = {.entry = {.count = 1, .reusable = true}}, SHIFT(404),
+  [681] = {.entry = {.count = 1, .reusable = true}}, SHIFT(405),
+  [683] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_import, 6, 0, 0),
+  [685] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_app, 6, 0, 0),
+  [687] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_app, 6, 0, 0),
+  [689] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_rec, 5, 0, 0),
+  [691] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_type_rec_repeat1, 4, 0, 0),
+  [693] = {.entry = {.count = 1, .reusable = true}}, SHIFT(406),
+  [695] = {.entry = {.count = 1, .reusable = true}}, SHIFT(407),
+  [697] = {.entry = {.count = 1, .reusable = true}}, SHIFT(408),
+  [699] = {.entry = {.count = 1, .reusable = true}}, SHIFT(410),
+  [701] = {.entry = {.count = 1, .reusable = true}}, SHIFT(411),
+  [703] = {.entry = {.count = 1, .reusable = true}}, SHIFT(412),
+  [705] = {.entry = {.count = 1, .reusable = true}}, SHIFT(414),
+  [707] = {.entry = {.count = 1, .reusable = true}}, SHIFT(415),
+  [709] = {.entry = {.count = 1, .reusable = true}}, SHIFT(416),
+  [711] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 5, 0, 0),
+  [713] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_type_cons_repeat1, 3, 0, 0),
+  [715] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_cons_repeat1, 3, 0, 0),
+  [717] = {.entry = {.count = 1, .reusable = true}}, SHIFT(418),
+  [719] = {.entry = {.count = 1, .reusable = true}}, SHIFT(419),
+  [721] = {.entry = {.count = 1, .reusable = true}}, SHIFT(420),
+  [723] = {.entry = {.count = 1, .reusable = true}}, SHIFT(422),
+  [725] = {.entry = {.count = 1, .reusable = true}}, SHIFT(423),
+  [727] = {.entry = {.count = 1, .reusable = true}}, SHIFT(424),
+  [729] = {.entry = {.count = 1, .reusable = true}}, SHIFT(425),
+  [731] = {.entry = {.count = 1, .reusable = true}}, SHIFT(426),
+  [733] = {.entry = {.count = 1, .reusable = true}}, SHIFT(427),
+  [735] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_tuple_repeat1, 3, 0, 0),
+  [737] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_union, 5, 0, 0),
+  [739] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_type_union_repeat1, 4, 0, 0),
+  [741] = {.entry = {.count = 1, .reusable = true}}, SHIFT(428),
+  [743] = {.entry = {.count = 1, .reusable = true}}, SHIFT(429),
+  [745] = {.entry = {.count = 1, .reusable = true}}, SHIFT(430),
+  [747] = {.entry = {.count = 1, .reusable = true}}, SHIFT(431),
+  [749] = {.entry = {.count = 1, .reusable = true}}, SHIFT(433),
+  [751] = {.entry = {.count = 1, .reusable = true}}, SHIFT(434),
+  [753] = {.entry = {.count = 1, .reusable = false}}, SHIFT(435),
+  [755] = {.entry = {.count = 1, .reusable = true}}, SHIFT(437),
+  [757] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_str_repeat1, 6, 0, 0),
+  [759] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_rec, 7, 0, 0),
+  [761] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_rec, 7, 0, 0),
+  [763] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_lam, 6, 0, 0),
+  [765] = {.entry = {.count = 1, .reusable = true}}, SHIFT(438),
+  [767] = {.entry = {.count = 1, .reusable = true}}, SHIFT(439),
+  [769] = {.entry = {.count = 1, .reusable = false}}, SHIFT(440),
+  [771] = {.entry = {.count = 1, .reusable = true}}, SHIFT(443),
+  [773] = {.entry = {.count = 1, .reusable = true}}, SHIFT(444),
+  [775] = {.entry = {.count = 1, .reusable = false}}, SHIFT(445),
+  [777] = {.entry = {.count = 1, .reusable = true}}, SHIFT(446),
+  [779] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 7, 0, 0),
+  [781] = {.entry = {.count = 1, .reusable = true}}, SHIFT(449),
+  [783] = {.entry = {.count = 1, .reusable = true}}, SHIFT(450),
+  [785] = {.entry = {.count = 1, .reusable = false}}, SHIFT(451),
+  [787] = {.entry = {.count = 1, .reusable = true}}, SHIFT(452),
+  [789] = {.entry = {.count = 1, .reusable = true}}, SHIFT(453),
+  [791] = {.entry = {.count = 1, .reusable = true}}, SHIFT(454),
+  [793] = {.entry = {.count = 1, .reusable = false}}, SHIFT(455),
+  [795] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_rec, 6, 0, 0),
+  [797] = {.entry = {.count = 1, .reusable = false}}, REDUCE(aux_sym_type_rec_repeat1, 5, 0, 0),
+  [799] = {.entry = {.count = 1, .reusable = true}}, SHIFT(458),
+  [801] = {.entry = {.count = 1, .reusable = true}}, SHIFT(459),
+  [803] = {.entry = {.count = 1, .reusable = true}}, SHIFT(460),
+  [805] = {.entry = {.count = 1, .reusable = true}}, SHIFT(462),
+  [807] = {.entry = {.count = 1, .reusable = true}}, SHIFT(463),
+  [809] = {.entry = {.count = 1, .reusable = true}}, SHIFT(464),
+  [811] = {.entry = {.count = 1, .reusable = true}}, SHIFT(465),
+  [813] = {.entry = {.count = 1, .reusable = true}}, SHIFT(466),
+  [815] = {.entry = {.count = 1, .reusable = true}}, SHIFT(467),
+  [817] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_cons, 6, 0, 0),
+  [819] = {.entry = {.count = 1, .reusable = true}}, SHIFT(468),
+  [821] = {.entry = {.count = 1, .reusable = true}}, SHIFT(469),
+  [823] = {.entry = {.count = 1, .reusable = true}}, SHIFT(470),
+  [825] = {.entry = {.count = 1, .reusable = true}}, SHIFT(471),
+  [827] = {.entry = {.count = 1, .reusable = true}}, SHIFT(472),
+  [829] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_union, 6, 0, 0),
+  [831] = {.entry = {.count = 1, .reusable = true}}, SHIFT(473),
+  [833] = {.entry = {.count = 1, .reusable = false}}, SHIFT(474),
+  [835] = {.entry = {.count = 1, .reusable = true}}, SHIFT(475),
+  [837] = {.entry = {.count = 1, .reusable = true}}, SHIFT(476),
+  [839] = {.entry = {.count = 1, .reusable = true}}, SHIFT(477),
+  [841] = {.entry = {.count = 1, .reusable = true}}, SHIFT(478),
+  [843] = {.entry = {.count = 1, .reusable = true}}, SHIFT(479),
+  [845] = {.entry = {.count = 1, .reusable = true}}, SHIFT(480),
+  [847] = {.entry = {.count = 1, .reusable = true}}, SHIFT(481),
+  [849] = {.entry = {.count = 1, .reusable = false}}, SHIFT(482),
+  [851] = {.entry = {.count = 1, .reusable = true}}, SHIFT(483),
+  [853] = {.entry = {.count = 1, .reusable = false}}, SHIFT(484),
+  [855] = {.entry = {.count = 1, .reusable = true}}, SHIFT(499),
+  [857] = {.entry = {.count = 1, .reusable = true}}, SHIFT(500),
+  [859] = {.entry = {.count = 1, .reusable = false}}, SHIFT(501),
+  [861] = {.entry = {.count = 1, .reusable = true}}, SHIFT(502),
+  [863] = {.entry = {.count = 1, .reusable = true}}, SHIFT(505),
+  [865] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 7, 0, 0),
+  [867] = {.entry = {.count = 1, .reusable = true}}, SHIFT(506),
+  [869] = {.entry = {.count = 1, .reusable = false}}, SHIFT(507),
+  [871] = {.entry = {.count = 1, .reusable = true}}, SHIFT(508),
+  [873] = {.entry = {.count = 1, .reusable = false}}, SHIFT(509),
+  [875] = {.entry = {.count = 1, .reusable = true}}, SHIFT(512),
+  [877] = {.entry = {.count = 1, .reusable = true}}, SHIFT(513),
+  [879] = {.entry = {.count = 1, .reusable = false}}, SHIFT(515),
+  [881] = {.entry = {.count = 1, .reusable = false}}, SHIFT(516),
+  [883] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 8, 0, 0),
+  [885] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_when_repeat1, 5, 0, 0),
+  [887] = {.entry = {.count = 1, .reusable = false}}, SHIFT(517),
+  [889] = {.entry = {.count = 1, .reusable = true}}, SHIFT(519),
+  [891] = {.entry = {.count = 1, .reusable = false}}, SHIFT(520),
+  [893] = {.entry = {.count = 1, .reusable = true}}, SHIFT(522),
+  [895] = {.entry = {.count = 1, .reusable = false}}, SHIFT(523),
+  [897] = {.entry = {.count = 1, .reusable = true}}, SHIFT(524),
+  [899] = {.entry = {.count = 1, .reusable = false}}, SHIFT(525),
+  [901] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_type_rec, 7, 0, 0),
+  [903] = {.entry = {.count = 1, .reusable = true}}, SHIFT(526),
+  [905] = {.entry = {.count = 1, .reusable = true}}, SHIFT(527),
+  [907] = {.entry = {.count = 1, .reusable = true}}, SHIFT(528),
+  [909] = {.entry = {.count = 1, .reusable = true}}, SHIFT(529),
+  [911] = {.entry = {.count = 1, .reusable = true}}, SHIFT(530),
+  [913] = {.entry = {.count = 1, .reusable = true}}, SHIFT(531),
+  [915] = {.entry = {.count = 1, .reusable = true}}, SHIFT(532),
+  [917] = {.entry = {.count = 1, .reusable = false}}, SHIFT(534),
+  [919] = {.entry = {.count = 1, .reusable = true}}, SHIFT(535),
+  [921] = {.entry = {.count = 1, .reusable = false}}, SHIFT(540),
+  [923] = {.entry = {.count = 1, .reusable = true}}, SHIFT(541),
+  [925] = {.entry = {.count = 1, .reusable = true}}, SHIFT(544),
+  [927] = {.entry = {.count = 1, .reusable = false}}, SHIFT(547),
+  [929] = {.entry = {.count = 1, .reusable = true}}, SHIFT(548),
+  [931] = {.entry = {.count = 1, .reusable = true}}, SHIFT(549),
+  [933] = {.entry = {.count = 1, .reusable = true}}, SHIFT(550),
+  [935] = {.entry = {.count = 1, .reusable = true}}, SHIFT(551),
+  [937] = {.entry = {.count = 1, .reusable = false}}, SHIFT(552),
+  [939] = {.entry = {.count = 1, .reusable = true}}, SHIFT(553),
+  [941] = {.entry = {.count = 1, .reusable = false}}, SHIFT(554),
+  [943] = {.entry = {.count = 1, .reusable = true}}, SHIFT(557),
+  [945] = {.entry = {.count = 1, .reusable = true}}, SHIFT(558),
+  [947] = {.entry = {.count = 1, .reusable = false}}, SHIFT(560),
+  [949] = {.entry = {.count = 1, .reusable = false}}, SHIFT(561),
+  [951] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 8, 0, 0),
+  [953] = {.entry = {.count = 1, .reusable = false}}, SHIFT(562),
+  [955] = {.entry = {.count = 1, .reusable = false}}, SHIFT(564),
+  [957] = {.entry = {.count = 1, .reusable = true}}, SHIFT(566),
+  [959] = {.entry = {.count = 1, .reusable = false}}, SHIFT(567),
+  [961] = {.entry = {.count = 1, .reusable = true}}, SHIFT(568),
+  [963] = {.entry = {.count = 1, .reusable = false}}, SHIFT(569),
+  [965] = {.entry = {.count = 1, .reusable = true}}, SHIFT(570),
+  [967] = {.entry = {.count = 1, .reusable = true}}, SHIFT(572),
+  [969] = {.entry = {.count = 1, .reusable = true}}, SHIFT(573),
+  [971] = {.entry = {.count = 1, .reusable = true}}, SHIFT(574),
+  [973] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 9, 0, 0),
+  [975] = {.entry = {.count = 1, .reusable = true}}, REDUCE(aux_sym_when_repeat1, 6, 0, 0),
+  [977] = {.entry = {.count = 1, .reusable = false}}, SHIFT(578),
+  [979] = {.entry = {.count = 1, .reusable = false}}, SHIFT(580),
+  [981] = {.entry = {.count = 1, .reusable = true}}, SHIFT(582),
+  [983] = {.entry = {.count = 1, .reusable = true}}, SHIFT(583),
+  [985] = {.entry = {.count = 1, .reusable = true}}, SHIFT(584),
+  [987] = {.entry = {.count = 1, .reusable = false}}, SHIFT(585),
+  [989] = {.entry = {.count = 1, .reusable = true}}, SHIFT(587),
+  [991] = {.entry = {.count = 1, .reusable = true}}, SHIFT(588),
+  [993] = {.entry = {.count = 1, .reusable = true}}, SHIFT(589),
+  [995] = {.entry = {.count = 1, .reusable = false}}, SHIFT(591),
+  [997] = {.entry = {.count = 1, .reusable = false}}, SHIFT(592),
+  [999] = {.entry = {.count = 1, .reusable = true}}, SHIFT(593),
+  [1001] = {.entry = {.count = 1, .reusable = true}}, SHIFT(594),
+  [1003] = {.entry = {.count = 1, .reusable = true}}, SHIFT(596),
+  [1005] = {.entry = {.count = 1, .reusable = true}}, SHIFT(600),
+  [1007] = {.entry = {.count = 1, .reusable = false}}, SHIFT(603),
+  [1009] = {.entry = {.count = 1, .reusable = false}}, SHIFT(604),
+  [1011] = {.entry = {.count = 1, .reusable = false}}, SHIFT(606),
+  [1013] = {.entry = {.count = 1, .reusable = true}}, SHIFT(608),
+  [1015] = {.entry = {.count = 1, .reusable = false}}, SHIFT(609),
+  [1017] = {.entry = {.count = 1, .reusable = true}}, SHIFT(610),
+  [1019] = {.entry = {.count = 1, .reusable = false}}, SHIFT(611),
+  [1021] = {.entry = {.count = 1, .reusable = true}}, SHIFT(612),
+  [1023] = {.entry = {.count = 1, .reusable = true}}, SHIFT(614),
+  [1025] = {.entry = {.count = 1, .reusable = true}}, SHIFT(615),
+  [1027] = {.entry = {.count = 1, .reusable = true}}, SHIFT(616),
+  [1029] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 9, 0, 0),
+  [1031] = {.entry = {.count = 1, .reusable = false}}, SHIFT(620),
+  [1033] = {.entry = {.count = 1, .reusable = false}}, SHIFT(622),
+  [1035] = {.entry = {.count = 1, .reusable = true}}, SHIFT(625),
+  [1037] = {.entry = {.count = 1, .reusable = false}}, SHIFT(627),
+  [1039] = {.entry = {.count = 1, .reusable = true}}, SHIFT(629),
+  [1041] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 10, 0, 0),
+  [1043] = {.entry = {.count = 1, .reusable = true}}, SHIFT(633),
+  [1045] = {.entry = {.count = 1, .reusable = true}}, SHIFT(634),
+  [1047] = {.entry = {.count = 1, .reusable = true}}, SHIFT(635),
+  [1049] = {.entry = {.count = 1, .reusable = true}}, SHIFT(636),
+  [1051] = {.entry = {.count = 1, .reusable = true}}, SHIFT(638),
+  [1053] = {.entry = {.count = 1, .reusable = true}}, SHIFT(639),
+  [1055] = {.entry = {.count = 1, .reusable = true}}, SHIFT(640),
+  [1057] = {.entry = {.count = 1, .reusable = true}}, SHIFT(642),
+  [1059] = {.entry = {.count = 1, .reusable = true}}, SHIFT(643),
+  [1061] = {.entry = {.count = 1, .reusable = true}}, SHIFT(644),
+  [1063] = {.entry = {.count = 1, .reusable = true}}, SHIFT(646),
+  [1065] = {.entry = {.count = 1, .reusable = true}}, SHIFT(647),
+  [1067] = {.entry = {.count = 1, .reusable = false}}, SHIFT(651),
+  [1069] = {.entry = {.count = 1, .reusable = false}}, SHIFT(653),
+  [1071] = {.entry = {.count = 1, .reusable = true}}, SHIFT(656),
+  [1073] = {.entry = {.count = 1, .reusable = false}}, SHIFT(658),
+  [1075] = {.entry = {.count = 1, .reusable = true}}, SHIFT(660),
+  [1077] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 10, 0, 0),
+  [1079] = {.entry = {.count = 1, .reusable = true}}, SHIFT(664),
+  [1081] = {.entry = {.count = 1, .reusable = true}}, SHIFT(665),
+  [1083] = {.entry = {.count = 1, .reusable = false}}, SHIFT(666),
+  [1085] = {.entry = {.count = 1, .reusable = true}}, REDUCE(sym_when, 11, 0, 0),
+  [1087] = {.entry = {.count = 1, .reusable = true}}, SHIFT(668),
+  [1089] = {.entry = {.count = 1, .reusable = true}}, SHIFT(669),
+  [1091] = {.entry = {.count = 1, .reusable = true}}, SHIFT(671),
+  [1093] = {.entry = {.count = 1, .reusable = true}}, SHIFT(673),
+  [1095] = {.entry = {.count = 1, .reusable = false}}, SHIFT(675),
+  [1097] = {.entry = {.count = 1, .reusable = true}}, SHIFT(677),
+  [1099] = {.entry = {.count = 1, .reusable = true}}, SHIFT(678),
+  [1101] = {.entry = {.count = 1, .reusable = true}}, SHIFT(679),
+  [1103] = {.entry = {.count = 1, .reusable = true}}, SHIFT(680),
+  [1105] = {.entry = {.count = 1, .reusable = true}}, SHIFT(681),
+  [1107] = {.entry = {.count = 1, .reusable = true}}, SHIFT(685),
+  [1109] = {.entry = {.count = 1, .reusable = true}}, SHIFT(686),
+  [1111] = {.entry = {.count = 1, .reusable = false}}, SHIFT(687),
+  [1113] = {.entry = {.count = 1, .reusable = false}}, REDUCE(sym_when, 11, 0, 0),
+  [1115] = {.entry = {.count = 1, .reusable = true}}, SHIFT(691),
+  [1117] = {.entry = {.count = 1, .reusable = true}}, SHIFT(692),
+  [1119] = {.entry = {.count = 1, .reusable = true}}, SHIFT(693),
+  [1121] = {.entry = {.count = 1, .reusable = true}}, SHIFT(694),
+  [1123] = {.entry = {.count = 1, .reusable = true}}, SHIFT(695),
+  [1125] = {.entry = {.count = 1, .reusable = true}}, SHIFT(697),
+  [1127] = {.entry = {.count = 1, .reusable = true}}, SHIFT(698),
+  [1129] = {.entry = {.count = 1, .reusable = false}}, SHIFT(699),
+  [1131] = {.entry = {.count = 1, .reusable = true}}, SHIFT(701),
+  [1133] = {.entry = {.count = 1, .reusable = true}}, SHIFT(704),
+  [1135] = {.entry = {.count = 1, .reusable = true}}, SHIFT(705),
+  [1137] = {.entry = {.count = 1, .reusable = false}}, SHIFT(706),
+  [1139] = {.entry = {.count = 1, .reusable = false}}, SHIFT(707),
+  [1141] = {.entry = {.count = 1, .reusable = true}}, SHIFT(710),
+  [1143] = {.entry = {.count = 1, .reusable = true}}, SHIFT(713),
+  [1145] = {.entry = {.count = 1, .reusable = true}}, SHIFT(714),
+  [1147] = {.entry = {.count = 1, .reusable = false}}, SHIFT(715),
+  [1149] = {.entry = {.count = 1, .reusable = false}}, SHIFT(716),
+  [1151] = {.entry = {.count = 1, .reusable = false}}, SHIFT(721),
+  [1153] = {.entry = {.count = 1, .reusable = false}}, SHIFT(722),
+  [1155] = {.entry = {.count = 1, .reusable = false}}, SHIFT(723),
+  [1157] = {.entry = {.count = 1, .reusable = false}}, SHIFT(724),
+  [1159] = {.entry = {.count = 1, .reusable = false}}, SHIFT(729),
+  [1161] = {.entry = {.count = 1, .reusable = false}}, SHIFT(730),
+  [1163] = {.entry = {.count = 1, .reusable = false}}, SHIFT(735),
+  [1165] = {.entry = {.count = 1, .reusable = false}}, SHIFT(736),
 };
 
 #ifdef __cplusplus